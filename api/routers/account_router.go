@@ -2,16 +2,19 @@ package routers
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterAccountRoutes registers routes for schema account.*
-func RegisterAccountRoutes(group *gin.RouterGroup, h handler.AccountHandlers) {
-	registerCRUDRoutes(group, "/oauth_providers", h.OAuthProvider)
-	registerCRUDRoutes(group, "/users", h.User)
-	registerCRUDRoutes(group, "/user_oauth_provider", h.UserOAuthProvider)
-	registerCRUDRoutes(group, "/roles", h.Role)
-	registerCRUDRoutes(group, "/user_roles", h.UserRole)
-	registerCRUDRoutes(group, "/permissions", h.Permission)
-	registerCRUDRoutes(group, "/role_permission", h.RolePermission)
+func RegisterAccountRoutes(group *gin.RouterGroup, h handler.AccountHandlers, require handler.PermissionGuard) {
+	manageUser := crudPermissions{Read: services.PermissionManageUser, Write: services.PermissionManageUser}
+
+	registerCRUDRoutes(group, "/oauth_providers", h.OAuthProvider, require, crudPermissions{Read: services.PermissionManageOAuth, Write: services.PermissionManageOAuth})
+	registerCRUDRoutes(group, "/users", h.User, require, manageUser)
+	registerCRUDRoutes(group, "/user_oauth_provider", h.UserOAuthProvider, require, manageUser)
+	registerCRUDRoutes(group, "/roles", h.Role, require, manageUser)
+	registerCRUDRoutes(group, "/user_roles", h.UserRole, require, manageUser)
+	registerCRUDRoutes(group, "/permissions", h.Permission, require, manageUser)
+	registerCRUDRoutes(group, "/role_permission", h.RolePermission, require, manageUser)
 }
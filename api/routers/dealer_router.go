@@ -2,13 +2,17 @@ package routers
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterDealerRoutes registers routes for schema dealer.*
-func RegisterDealerRoutes(group *gin.RouterGroup, h handler.DealerHandlers) {
-	registerCRUDRoutes(group, "/motor_types", h.MotorType)
-	registerCRUDRoutes(group, "/motors", h.Motor)
-	registerCRUDRoutes(group, "/motor_assets", h.MotorAsset)
-	registerCRUDRoutes(group, "/customer", h.Customer)
+func RegisterDealerRoutes(group *gin.RouterGroup, h handler.DealerHandlers, require handler.PermissionGuard) {
+	masterData := crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionManageMasterData}
+
+	registerCRUDRoutes(group, "/motor_types", h.MotorType, require, masterData)
+	registerCRUDRoutes(group, "/motors", h.Motor, require, masterData)
+	registerCRUDRoutes(group, "/motor_assets", h.MotorAsset, require, masterData)
+	registerCRUDRoutes(group, "/customer", h.Customer, require, crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionCreateContract})
+	registerCRUDRoutes(group, "/customer_blacklist", h.CustomerBlacklist, require, crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionApproveContract})
 }
@@ -0,0 +1,22 @@
+package routers
+
+import (
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFileRoutes registers multipart upload and download routes next to the CRUD resources they fill.
+func RegisterFileRoutes(group *gin.RouterGroup, h *handler.FileHandler, require handler.PermissionGuard) {
+	group.POST("/leasing/leasing_contract_documents/upload", require(services.PermissionCreateContract), h.UploadContractDocument)
+	group.GET("/leasing/leasing_contract_documents/:id/download", require(services.PermissionViewContract), h.DownloadContractDocument)
+	group.GET("/leasing/leasing_contract_documents/:id/signed-url", require(services.PermissionViewContract), h.ContractDocumentSignedURL)
+	group.POST("/dealer/motor_assets/upload", require(services.PermissionManageMasterData), h.UploadMotorAsset)
+	group.GET("/dealer/motor_assets/:id/download", require(services.PermissionViewContract), h.DownloadMotorAsset)
+}
+
+// RegisterSignedFileRoutes serves signed URLs of the local and memory storage backends; the signature
+// replaces the bearer token, so the group must not require auth.
+func RegisterSignedFileRoutes(group *gin.RouterGroup, h *handler.FileHandler) {
+	group.GET("/*key", h.ServeSignedFile)
+}
@@ -5,10 +5,16 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func registerCRUDRoutes(group *gin.RouterGroup, resource string, h handler.ResourceHandler) {
-	group.GET(resource, h.List)
-	group.GET(resource+"/:id", h.GetByID)
-	group.POST(resource, h.Create)
-	group.PUT(resource+"/:id", h.Update)
-	group.DELETE(resource+"/:id", h.Delete)
+// crudPermissions declares the permission required to read and to modify a resource.
+type crudPermissions struct {
+	Read  string
+	Write string
+}
+
+func registerCRUDRoutes(group *gin.RouterGroup, resource string, h handler.ResourceHandler, require handler.PermissionGuard, perms crudPermissions) {
+	group.GET(resource, require(perms.Read), h.List)
+	group.GET(resource+"/:id", require(perms.Read), h.GetByID)
+	group.POST(resource, require(perms.Write), h.Create)
+	group.PUT(resource+"/:id", require(perms.Write), h.Update)
+	group.DELETE(resource+"/:id", require(perms.Write), h.Delete)
 }
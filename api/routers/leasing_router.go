@@ -2,18 +2,23 @@ package routers
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterLeasingRoutes registers routes for schema leasing.*
-func RegisterLeasingRoutes(group *gin.RouterGroup, h handler.LeasingHandlers) {
-	registerCRUDRoutes(group, "/leasing_product", h.LeasingProduct)
-	registerCRUDRoutes(group, "/leasing_contract", h.LeasingContract)
-	registerCRUDRoutes(group, "/leasing_tasks", h.LeasingTask)
-	registerCRUDRoutes(group, "/leasing_tasks_attributes", h.LeasingTaskAttribute)
-	registerCRUDRoutes(group, "/leasing_contract_documents", h.LeasingContractDocument)
+func RegisterLeasingRoutes(group *gin.RouterGroup, h handler.LeasingHandlers, require handler.PermissionGuard) {
+	contractData := crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionApproveContract}
+
+	registerCRUDRoutes(group, "/leasing_product", h.LeasingProduct, require, crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionManageMasterData})
+	registerCRUDRoutes(group, "/insurance_rates", h.InsuranceRate, require, crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionManageMasterData})
+	registerCRUDRoutes(group, "/leasing_contract", h.LeasingContract, require, contractData)
+	group.GET("/leasing_contract/:id/history", require(services.PermissionViewContract), h.LeasingContract.History)
+	registerCRUDRoutes(group, "/leasing_tasks", h.LeasingTask, require, contractData)
+	registerCRUDRoutes(group, "/leasing_tasks_attributes", h.LeasingTaskAttribute, require, contractData)
+	registerCRUDRoutes(group, "/leasing_contract_documents", h.LeasingContractDocument, require, crudPermissions{Read: services.PermissionViewContract, Write: services.PermissionCreateContract})
 
 	if h.Workflow != nil {
-		h.Workflow.RegisterRoutes(group)
+		h.Workflow.RegisterRoutes(group, require)
 	}
 }
@@ -2,16 +2,19 @@ package routers
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterMSTRoutes registers routes for schema mst.*
-func RegisterMSTRoutes(group *gin.RouterGroup, h handler.MSTHandlers) {
-	registerCRUDRoutes(group, "/province", h.Province)
-	registerCRUDRoutes(group, "/kabupaten", h.Kabupaten)
-	registerCRUDRoutes(group, "/kecamatan", h.Kecamatan)
-	registerCRUDRoutes(group, "/kelurahan", h.Kelurahan)
-	registerCRUDRoutes(group, "/locations", h.Location)
-	registerCRUDRoutes(group, "/template_tasks", h.TemplateTask)
-	registerCRUDRoutes(group, "/template_task_attributes", h.TemplateTaskAttribute)
+func RegisterMSTRoutes(group *gin.RouterGroup, h handler.MSTHandlers, require handler.PermissionGuard) {
+	masterData := crudPermissions{Read: services.PermissionViewDashboard, Write: services.PermissionManageMasterData}
+
+	registerCRUDRoutes(group, "/province", h.Province, require, masterData)
+	registerCRUDRoutes(group, "/kabupaten", h.Kabupaten, require, masterData)
+	registerCRUDRoutes(group, "/kecamatan", h.Kecamatan, require, masterData)
+	registerCRUDRoutes(group, "/kelurahan", h.Kelurahan, require, masterData)
+	registerCRUDRoutes(group, "/locations", h.Location, require, masterData)
+	registerCRUDRoutes(group, "/template_tasks", h.TemplateTask, require, masterData)
+	registerCRUDRoutes(group, "/template_task_attributes", h.TemplateTaskAttribute, require, masterData)
 }
@@ -2,11 +2,21 @@ package routers
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterPaymentRoutes registers routes for schema payment.*
-func RegisterPaymentRoutes(group *gin.RouterGroup, h handler.PaymentHandlers) {
-	registerCRUDRoutes(group, "/payment_schedule", h.PaymentSchedule)
-	registerCRUDRoutes(group, "/payments", h.Payment)
+func RegisterPaymentRoutes(group *gin.RouterGroup, h handler.PaymentHandlers, require handler.PermissionGuard) {
+	paymentData := crudPermissions{Read: services.PermissionViewPayment, Write: services.PermissionRecordPayment}
+
+	registerCRUDRoutes(group, "/payment_schedule", h.PaymentSchedule, require, paymentData)
+	registerCRUDRoutes(group, "/payments", h.Payment, require, paymentData)
+
+	if h.Posting != nil {
+		group.POST("/payments/posting", require(services.PermissionRecordPayment), h.Posting.PostPayment)
+	}
+	if h.Penalty != nil {
+		group.GET("/penalties/:contract_id", require(services.PermissionViewPayment), h.Penalty.ContractPenalties)
+	}
 }
@@ -6,12 +6,22 @@ import (
 )
 
 // RegisterERDRouters registers all routers grouped by ERD schema.
+// Only /account/auth and signed /uploads URLs are public; every other route requires a bearer token and
+// the permission declared per route.
 func RegisterERDRouters(engine *gin.Engine, basePath string, h *handler.Handlers) {
 	root := engine.Group(basePath)
 
-	RegisterMSTRoutes(root.Group("/mst"), h.MST)
-	RegisterAccountRoutes(root.Group("/account"), h.Account)
-	RegisterDealerRoutes(root.Group("/dealer"), h.Dealer)
-	RegisterLeasingRoutes(root.Group("/leasing"), h.Leasing)
-	RegisterPaymentRoutes(root.Group("/payment"), h.Payment)
+	h.Auth.RegisterRoutes(root.Group("/account"))
+	RegisterSignedFileRoutes(root.Group("/uploads"), h.Files)
+
+	secured := root.Group("")
+	secured.Use(h.Auth.RequireAuth())
+	require := h.Auth.RequirePermission
+
+	RegisterMSTRoutes(secured.Group("/mst"), h.MST, require)
+	RegisterAccountRoutes(secured.Group("/account"), h.Account, require)
+	RegisterDealerRoutes(secured.Group("/dealer"), h.Dealer, require)
+	RegisterLeasingRoutes(secured.Group("/leasing"), h.Leasing, require)
+	RegisterPaymentRoutes(secured.Group("/payment"), h.Payment, require)
+	RegisterFileRoutes(secured, h.Files, require)
 }
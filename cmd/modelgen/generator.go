@@ -54,17 +54,24 @@ func main() {
 		models.UserRole{},
 		models.Permission{},
 		models.RolePermission{},
+		models.RefreshToken{},
 		models.MotorType{},
 		models.Motor{},
 		models.MotorAsset{},
 		models.Customer{},
+		models.CreditBureauReport{},
+		models.CustomerBlacklist{},
 		models.LeasingProduct{},
+		models.InsuranceRate{},
 		models.LeasingContract{},
 		models.LeasingTask{},
 		models.LeasingTaskAttribute{},
 		models.LeasingContractDocument{},
+		models.ContractStatusHistory{},
 		models.PaymentSchedule{},
 		models.Payment{},
+		models.PaymentAllocation{},
+		models.PayoffQuote{},
 	)
 
 	g.Execute()
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+)
+
+// runCommand executes a one-off CLI subcommand instead of starting the HTTP server,
+// e.g. `go run . sweep-overdue`.
+func runCommand(args []string, svcs *services.Services) error {
+	switch name := args[0]; name {
+	case "sweep-overdue":
+		result, err := svcs.Payment.OverdueSweep.Sweep(context.Background())
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(os.Stdout).Encode(result)
+	case "set-password":
+		// the password comes from the environment so it never shows up in the process list or shell history
+		if len(args) != 2 {
+			return fmt.Errorf("usage: NEW_PASSWORD=... set-password <username|email|phone>")
+		}
+		return svcs.Auth.SetPassword(context.Background(), args[1], os.Getenv("NEW_PASSWORD"))
+	default:
+		return fmt.Errorf("unknown command %q (available: sweep-overdue, set-password)", name)
+	}
+}
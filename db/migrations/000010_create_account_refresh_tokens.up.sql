@@ -0,0 +1,15 @@
+-- Schema: account (sesi login & refresh token)
+
+-- 1. refresh_tokens <<account>>
+CREATE TABLE account.refresh_tokens (
+    token_id        BIGSERIAL PRIMARY KEY,
+    token_hash      VARCHAR(64) NOT NULL UNIQUE,
+    expires_at      TIMESTAMPTZ NOT NULL,
+    revoked_at      TIMESTAMPTZ,
+    replaced_by_id  BIGINT REFERENCES account.refresh_tokens(token_id) ON DELETE SET NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    user_id         BIGINT NOT NULL REFERENCES account.users(user_id) ON DELETE CASCADE
+);
+
+-- Index
+CREATE INDEX idx_refresh_tokens_user ON account.refresh_tokens(user_id);
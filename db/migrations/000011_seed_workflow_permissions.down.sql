@@ -0,0 +1,7 @@
+DELETE FROM account.role_permission rp
+USING account.permissions p
+WHERE rp.permission_id = p.permission_id
+  AND p.permission_type IN ('manage_master_data', 'record_initial_payment', 'process_purchase_order');
+
+DELETE FROM account.permissions
+WHERE permission_type IN ('manage_master_data', 'record_initial_payment', 'process_purchase_order');
@@ -0,0 +1,24 @@
+-- 1. permissions <<account>> (permission tambahan untuk route workflow & master data)
+INSERT INTO account.permissions (permission_type, description) VALUES
+('manage_master_data', 'Kelola master data wilayah, template task, motor & produk leasing'),
+('record_initial_payment', 'Catat pembayaran DP + biaya awal kontrak'),
+('process_purchase_order', 'Proses PO & pembelian unit ke dealer');
+
+-- 2. role_permission
+INSERT INTO account.role_permission (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM account.roles r, account.permissions p
+WHERE r.role_name = 'SUPER_ADMIN'
+  AND p.permission_type IN ('manage_master_data', 'record_initial_payment', 'process_purchase_order');
+
+INSERT INTO account.role_permission (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM account.roles r, account.permissions p
+WHERE r.role_name = 'ADMIN_CABANG'
+  AND p.permission_type IN ('manage_master_data');
+
+INSERT INTO account.role_permission (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM account.roles r, account.permissions p
+WHERE r.role_name = 'FINANCE'
+  AND p.permission_type IN ('record_initial_payment', 'process_purchase_order');
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS leasing.idx_leasing_tasks_completed_by;
+
+ALTER TABLE leasing.leasing_tasks
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS completed_by;
@@ -0,0 +1,9 @@
+-- Schema: leasing (audit penyelesaian task workflow)
+
+-- 1. leasing_tasks <<leasing>>
+ALTER TABLE leasing.leasing_tasks
+    ADD COLUMN completed_by BIGINT REFERENCES account.users(user_id) ON DELETE SET NULL,
+    ADD COLUMN completed_at TIMESTAMPTZ;
+
+-- Index
+CREATE INDEX idx_leasing_tasks_completed_by ON leasing.leasing_tasks(completed_by);
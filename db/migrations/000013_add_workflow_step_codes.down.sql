@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS leasing.idx_leasing_tasks_contract_code;
+
+ALTER TABLE leasing.leasing_tasks
+    DROP COLUMN IF EXISTS task_code;
+
+ALTER TABLE mst.template_tasks
+    DROP COLUMN IF EXISTS teta_code;
@@ -0,0 +1,33 @@
+-- Schema: mst & leasing (kode step workflow yang stabil)
+
+-- 1. template_tasks <<mst>>
+ALTER TABLE mst.template_tasks
+    ADD COLUMN teta_code VARCHAR(30) UNIQUE;
+
+UPDATE mst.template_tasks SET teta_code = CASE teta_name
+    WHEN 'Input Pengajuan & Unggah Dokumen' THEN 'submit_application'
+    WHEN 'Auto Scoring Awal & Pre-Approval' THEN 'auto_scoring'
+    WHEN 'Survei Lapangan / Home Visit' THEN 'field_survey'
+    WHEN 'Input Hasil Survei & Rekomendasi' THEN 'survey_result'
+    WHEN 'Review & Approval Final (ACC/Reject)' THEN 'final_approval'
+    WHEN 'Akad & Tanda Tangan Kontrak' THEN 'akad'
+    WHEN 'Pembayaran DP + Biaya Awal' THEN 'initial_payment'
+    WHEN 'Proses PO & Pembelian Unit ke Dealer' THEN 'dealer_po'
+    WHEN 'Delivery Motor ke Rumah Customer' THEN 'delivery'
+    WHEN 'Mulai Cicilan & Monitoring Pembayaran' THEN 'installment_monitoring'
+    WHEN 'System Closed' THEN 'system_closed'
+END
+WHERE teta_code IS NULL;
+
+-- 2. leasing_tasks <<leasing>>
+ALTER TABLE leasing.leasing_tasks
+    ADD COLUMN task_code VARCHAR(30);
+
+UPDATE leasing.leasing_tasks lt
+SET task_code = tt.teta_code
+FROM mst.template_tasks tt
+WHERE lt.task_name = tt.teta_name
+  AND lt.task_code IS NULL;
+
+-- Index
+CREATE INDEX idx_leasing_tasks_contract_code ON leasing.leasing_tasks(contract_id, task_code);
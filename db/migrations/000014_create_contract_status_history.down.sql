@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS leasing.contract_status_history CASCADE;
+DROP FUNCTION IF EXISTS leasing.prevent_status_history_update();
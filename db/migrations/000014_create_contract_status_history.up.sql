@@ -0,0 +1,31 @@
+-- Schema: leasing (riwayat perubahan status kontrak)
+
+-- 1. contract_status_history <<leasing>>
+CREATE TABLE leasing.contract_status_history (
+    history_id   BIGSERIAL PRIMARY KEY,
+    from_status  VARCHAR(20),
+    to_status    VARCHAR(20) NOT NULL,
+    reason       TEXT,
+    actor_id     BIGINT REFERENCES account.users(user_id) ON DELETE SET NULL,
+    created_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    contract_id  BIGINT NOT NULL REFERENCES leasing.leasing_contract(contract_id) ON DELETE CASCADE
+);
+
+-- append-only: baris riwayat tidak boleh diubah
+CREATE FUNCTION leasing.prevent_status_history_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'leasing.contract_status_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_contract_status_history_no_update
+    BEFORE UPDATE ON leasing.contract_status_history
+    FOR EACH ROW EXECUTE FUNCTION leasing.prevent_status_history_update();
+
+-- backfill status saat ini untuk kontrak yang sudah ada
+INSERT INTO leasing.contract_status_history (from_status, to_status, reason, created_at, contract_id)
+SELECT NULL, status, 'initial status (backfill)', COALESCE(updated_at, created_at, CURRENT_TIMESTAMP), contract_id
+FROM leasing.leasing_contract;
+
+-- Index
+CREATE INDEX idx_contract_status_history_contract ON leasing.contract_status_history(contract_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS payment.payment_allocations CASCADE;
+ALTER TABLE leasing.leasing_contract DROP COLUMN IF EXISTS saldo_kredit;
+ALTER TABLE payment.payment_schedule DROP COLUMN IF EXISTS terbayar;
@@ -0,0 +1,28 @@
+-- Schema: payment (alokasi pembayaran per angsuran + saldo kredit kontrak)
+
+-- 1. payment_schedule <<payment>>
+ALTER TABLE payment.payment_schedule
+    ADD COLUMN terbayar NUMERIC(15,2) NOT NULL DEFAULT 0;
+
+-- angsuran yang sudah lunas dianggap terbayar penuh
+UPDATE payment.payment_schedule
+SET terbayar = total_tagihan
+WHERE status_pembayaran = 'paid';
+
+-- 2. leasing_contract <<leasing>>
+ALTER TABLE leasing.leasing_contract
+    ADD COLUMN saldo_kredit NUMERIC(15,2) NOT NULL DEFAULT 0;
+
+-- 3. payment_allocations <<payment>>
+CREATE TABLE payment.payment_allocations (
+    allocation_id  BIGSERIAL PRIMARY KEY,
+    kind           VARCHAR(20) NOT NULL,
+    amount         NUMERIC(15,2) NOT NULL,
+    created_at     TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    payment_id     BIGINT NOT NULL REFERENCES payment.payments(payment_id) ON DELETE CASCADE,
+    schedule_id    BIGINT REFERENCES payment.payment_schedule(schedule_id) ON DELETE SET NULL
+);
+
+-- Index
+CREATE INDEX idx_payment_allocations_payment ON payment.payment_allocations(payment_id);
+CREATE INDEX idx_payment_allocations_schedule ON payment.payment_allocations(schedule_id);
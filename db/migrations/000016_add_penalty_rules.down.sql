@@ -0,0 +1,10 @@
+ALTER TABLE payment.payment_schedule
+    DROP COLUMN IF EXISTS denda_terbayar,
+    DROP COLUMN IF EXISTS denda;
+
+ALTER TABLE leasing.leasing_product
+    DROP CONSTRAINT IF EXISTS chk_leasing_product_denda_tipe,
+    DROP COLUMN IF EXISTS denda_maks,
+    DROP COLUMN IF EXISTS denda_grace_hari,
+    DROP COLUMN IF EXISTS denda_nilai,
+    DROP COLUMN IF EXISTS denda_tipe;
@@ -0,0 +1,15 @@
+-- Schema: leasing, payment (aturan denda keterlambatan per produk)
+
+-- 1. leasing_product <<leasing>>
+-- daily_rate: denda_nilai persen per hari dari sisa tagihan; fixed: denda_nilai rupiah sekali setelah masa tenggang
+ALTER TABLE leasing.leasing_product
+    ADD COLUMN denda_tipe       VARCHAR(15)   NOT NULL DEFAULT 'daily_rate',
+    ADD COLUMN denda_nilai      NUMERIC(12,4) NOT NULL DEFAULT 0,
+    ADD COLUMN denda_grace_hari SMALLINT      NOT NULL DEFAULT 0,
+    ADD COLUMN denda_maks       NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_leasing_product_denda_tipe CHECK (denda_tipe IN ('daily_rate', 'fixed'));
+
+-- 2. payment_schedule <<payment>>
+ALTER TABLE payment.payment_schedule
+    ADD COLUMN denda          NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN denda_terbayar NUMERIC(15,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+ALTER TABLE leasing.leasing_contract
+    DROP COLUMN IF EXISTS biaya_dimuka,
+    DROP COLUMN IF EXISTS biaya_dibiayai,
+    DROP COLUMN IF EXISTS biaya_fidusia,
+    DROP COLUMN IF EXISTS asuransi_premi,
+    DROP COLUMN IF EXISTS admin_fee;
+
+DROP TABLE IF EXISTS leasing.insurance_rates;
+
+ALTER TABLE leasing.leasing_product
+    DROP COLUMN IF EXISTS fidusia_dibiayai,
+    DROP COLUMN IF EXISTS biaya_fidusia,
+    DROP COLUMN IF EXISTS asuransi_dibiayai,
+    DROP COLUMN IF EXISTS admin_fee_dibiayai;
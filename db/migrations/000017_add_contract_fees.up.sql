@@ -0,0 +1,39 @@
+-- Schema: leasing (biaya admin, asuransi dan fidusia pada kontrak)
+
+-- 1. leasing_product <<leasing>>
+-- *_dibiayai = TRUE: biaya ditambahkan ke pokok pinjaman; FALSE: dibayar di muka bersama DP
+ALTER TABLE leasing.leasing_product
+    ADD COLUMN admin_fee_dibiayai BOOLEAN       NOT NULL DEFAULT FALSE,
+    ADD COLUMN asuransi_dibiayai  BOOLEAN       NOT NULL DEFAULT FALSE,
+    ADD COLUMN biaya_fidusia      NUMERIC(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN fidusia_dibiayai   BOOLEAN       NOT NULL DEFAULT FALSE;
+
+-- 2. insurance_rates <<leasing>>
+-- rate_persen dari harga OTR untuk seluruh tenor; moty_id NULL = tarif umum semua tipe motor
+CREATE TABLE leasing.insurance_rates (
+    rate_id     BIGSERIAL PRIMARY KEY,
+    tenor_bulan SMALLINT     NOT NULL CHECK (tenor_bulan > 0),
+    rate_persen NUMERIC(6,3) NOT NULL CHECK (rate_persen >= 0),
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    moty_id     BIGINT REFERENCES dealer.motor_types(moty_id) ON DELETE CASCADE
+);
+
+-- 3. leasing_contract <<leasing>>
+ALTER TABLE leasing.leasing_contract
+    ADD COLUMN admin_fee      NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN asuransi_premi NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN biaya_fidusia  NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN biaya_dibiayai NUMERIC(15,2) NOT NULL DEFAULT 0,
+    ADD COLUMN biaya_dimuka   NUMERIC(15,2) NOT NULL DEFAULT 0;
+
+-- Index
+CREATE UNIQUE INDEX uq_insurance_rates_moty_tenor ON leasing.insurance_rates (COALESCE(moty_id, 0), tenor_bulan);
+CREATE INDEX idx_insurance_rates_moty_id ON leasing.insurance_rates (moty_id);
+
+-- Seed tarif umum
+INSERT INTO leasing.insurance_rates (tenor_bulan, rate_persen) VALUES
+    (12, 3.000),
+    (18, 3.500),
+    (24, 4.000),
+    (36, 5.000),
+    (48, 6.000);
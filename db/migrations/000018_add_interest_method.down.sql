@@ -0,0 +1,4 @@
+ALTER TABLE leasing.leasing_product
+    DROP CONSTRAINT IF EXISTS chk_leasing_product_metode_bunga,
+    DROP COLUMN IF EXISTS bunga_efektif,
+    DROP COLUMN IF EXISTS metode_bunga;
@@ -0,0 +1,8 @@
+-- Schema: leasing (metode bunga produk: flat atau efektif/anuitas)
+
+-- 1. leasing_product <<leasing>>
+-- flat: margin = pokok x bunga_flat x tenor/12, dibagi rata; effective: bunga_efektif per tahun atas sisa pokok (anuitas)
+ALTER TABLE leasing.leasing_product
+    ADD COLUMN metode_bunga  VARCHAR(15)  NOT NULL DEFAULT 'flat',
+    ADD COLUMN bunga_efektif NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_leasing_product_metode_bunga CHECK (metode_bunga IN ('flat', 'effective'));
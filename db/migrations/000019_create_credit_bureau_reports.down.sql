@@ -0,0 +1 @@
+DROP TABLE IF EXISTS dealer.credit_bureau_reports;
@@ -0,0 +1,18 @@
+-- Schema: dealer (hasil cek SLIK OJK / biro kredit per customer)
+
+-- 1. credit_bureau_reports <<dealer>>
+-- kolektibilitas 1 (lancar) .. 5 (macet), 0 = tidak ada riwayat; dipakai ulang sampai expires_at
+CREATE TABLE dealer.credit_bureau_reports (
+    report_id         BIGSERIAL PRIMARY KEY,
+    provider          VARCHAR(30)   NOT NULL,
+    reference_id      VARCHAR(60),
+    kolektibilitas    SMALLINT      NOT NULL CHECK (kolektibilitas BETWEEN 0 AND 5),
+    jumlah_fasilitas  INT           NOT NULL DEFAULT 0,
+    total_outstanding NUMERIC(15,2) NOT NULL DEFAULT 0,
+    checked_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    expires_at        TIMESTAMPTZ   NOT NULL,
+    customer_id       BIGINT        NOT NULL REFERENCES dealer.customers(customer_id) ON DELETE CASCADE
+);
+
+-- Index
+CREATE INDEX idx_credit_bureau_reports_customer_expires ON dealer.credit_bureau_reports (customer_id, expires_at DESC);
@@ -0,0 +1,5 @@
+ALTER TABLE leasing.leasing_contract
+    DROP COLUMN IF EXISTS review_reasons,
+    DROP COLUMN IF EXISTS manual_review;
+
+DROP TABLE IF EXISTS dealer.customer_blacklist;
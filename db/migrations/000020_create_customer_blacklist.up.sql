@@ -0,0 +1,23 @@
+-- Schema: dealer (blacklist customer) & leasing (flag review manual saat pengajuan)
+
+-- 1. customer_blacklist <<dealer>>
+-- dicocokkan berdasarkan NIK; expires_at NULL = berlaku permanen
+CREATE TABLE dealer.customer_blacklist (
+    blacklist_id BIGSERIAL PRIMARY KEY,
+    nik          VARCHAR(16)  NOT NULL,
+    reason       VARCHAR(255) NOT NULL,
+    expires_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    customer_id  BIGINT REFERENCES dealer.customers(customer_id) ON DELETE SET NULL
+);
+
+-- 2. leasing_contract <<leasing>>
+-- manual_review = TRUE: pengecekan pra-pengajuan menandai kontrak, auto scoring tidak boleh langsung approve
+ALTER TABLE leasing.leasing_contract
+    ADD COLUMN manual_review  BOOLEAN      NOT NULL DEFAULT FALSE,
+    ADD COLUMN review_reasons VARCHAR(255);
+
+-- Index
+CREATE INDEX idx_customer_blacklist_nik ON dealer.customer_blacklist (nik);
+CREATE INDEX idx_customer_blacklist_customer_id ON dealer.customer_blacklist (customer_id);
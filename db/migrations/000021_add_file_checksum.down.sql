@@ -0,0 +1,7 @@
+ALTER TABLE leasing.leasing_contract_documents
+    DROP COLUMN IF EXISTS checksum,
+    DROP COLUMN IF EXISTS storage_path;
+
+ALTER TABLE dealer.motor_assets
+    DROP COLUMN IF EXISTS checksum,
+    DROP COLUMN IF EXISTS storage_path;
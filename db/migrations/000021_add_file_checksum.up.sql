@@ -0,0 +1,10 @@
+-- Schema: dealer & leasing (file upload: lokasi penyimpanan dan checksum)
+
+-- storage_path relatif terhadap STORAGE.UPLOAD_PATH; checksum = SHA-256 hex isi file
+ALTER TABLE dealer.motor_assets
+    ADD COLUMN storage_path VARCHAR(255),
+    ADD COLUMN checksum     CHAR(64);
+
+ALTER TABLE leasing.leasing_contract_documents
+    ADD COLUMN storage_path VARCHAR(255),
+    ADD COLUMN checksum     CHAR(64);
@@ -0,0 +1,2 @@
+ALTER TABLE leasing.leasing_contract_documents
+    DROP COLUMN IF EXISTS doc_type;
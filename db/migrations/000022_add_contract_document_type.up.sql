@@ -0,0 +1,10 @@
+-- Schema: leasing (jenis dokumen kontrak)
+
+-- doc_type menentukan cara baca: ktp/kk hanya lewat signed URL berumur pendek (STORAGE.SIGNED_DOCUMENT_TYPES)
+ALTER TABLE leasing.leasing_contract_documents
+    ADD COLUMN doc_type VARCHAR(15) NOT NULL DEFAULT 'other'
+        CHECK (doc_type IN ('ktp', 'kk', 'akad', 'unit', 'other'));
+
+UPDATE leasing.leasing_contract_documents SET doc_type = 'ktp' WHERE file_name ILIKE 'ktp%';
+UPDATE leasing.leasing_contract_documents SET doc_type = 'kk' WHERE file_name ILIKE 'kk%';
+UPDATE leasing.leasing_contract_documents SET doc_type = 'akad' WHERE file_name ILIKE '%akad%';
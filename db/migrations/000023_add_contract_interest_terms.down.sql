@@ -0,0 +1,5 @@
+ALTER TABLE leasing.leasing_contract
+    DROP CONSTRAINT IF EXISTS chk_leasing_contract_metode_bunga,
+    DROP COLUMN IF EXISTS bunga_efektif,
+    DROP COLUMN IF EXISTS bunga_flat,
+    DROP COLUMN IF EXISTS metode_bunga;
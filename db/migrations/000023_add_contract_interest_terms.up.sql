@@ -0,0 +1,16 @@
+-- Schema: leasing (syarat bunga dibekukan di kontrak saat pengajuan)
+
+-- 1. leasing_contract <<leasing>>
+-- jadwal angsuran dihitung dari syarat bunga kontrak, bukan dari produk saat ini
+ALTER TABLE leasing.leasing_contract
+    ADD COLUMN metode_bunga  VARCHAR(15)  NOT NULL DEFAULT 'flat',
+    ADD COLUMN bunga_flat    NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD COLUMN bunga_efektif NUMERIC(5,2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_leasing_contract_metode_bunga CHECK (metode_bunga IN ('flat', 'effective'));
+
+UPDATE leasing.leasing_contract c
+SET metode_bunga  = p.metode_bunga,
+    bunga_flat    = p.bunga_flat,
+    bunga_efektif = p.bunga_efektif
+FROM leasing.leasing_product p
+WHERE p.product_id = c.product_id;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS payment.payoff_quotes CASCADE;
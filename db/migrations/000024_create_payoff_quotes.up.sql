@@ -0,0 +1,27 @@
+-- Schema: payment (quote pelunasan dipercepat yang sudah diterbitkan)
+
+-- 1. payoff_quotes <<payment>>
+-- payoff hanya bisa dibayar terhadap quote yang tersimpan di sini, sesuai nominal dan valid_until-nya
+CREATE TABLE payment.payoff_quotes (
+    quote_id               BIGSERIAL PRIMARY KEY,
+    quote_date             DATE NOT NULL,
+    valid_until            DATE NOT NULL,
+    rebate_policy          VARCHAR(20) NOT NULL,
+    remaining_installments INTEGER NOT NULL,
+    remaining_principal    NUMERIC(15,2) NOT NULL,
+    outstanding_margin     NUMERIC(15,2) NOT NULL,
+    unearned_margin        NUMERIC(15,2) NOT NULL,
+    margin_rebate          NUMERIC(15,2) NOT NULL,
+    penalties              NUMERIC(15,2) NOT NULL,
+    fee                    NUMERIC(15,2) NOT NULL,
+    credit_balance         NUMERIC(15,2) NOT NULL,
+    payoff_amount          NUMERIC(15,2) NOT NULL,
+    settled_at             TIMESTAMPTZ,
+    created_at             TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    contract_id            BIGINT NOT NULL REFERENCES leasing.leasing_contract(contract_id) ON DELETE CASCADE,
+    payment_id             BIGINT REFERENCES payment.payments(payment_id) ON DELETE SET NULL,
+    CONSTRAINT chk_payoff_quotes_validity CHECK (valid_until >= quote_date)
+);
+
+-- Index
+CREATE INDEX idx_payoff_quotes_contract ON payment.payoff_quotes(contract_id);
@@ -4,19 +4,28 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"reflect"
 	"runtime"
 
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/spf13/viper"
 )
 
 // Config maps the root structure of configs.development.toml.
 type Config struct {
-	Environment string         `mapstructure:"ENVIRONMENT" toml:"ENVIRONMENT"`
-	Server      ServerConfig   `mapstructure:"SERVER" toml:"SERVER"`
-	Database    DatabaseConfig `mapstructure:"DATABASE" toml:"DATABASE"`
-	JWT         JWTConfig      `mapstructure:"JWT" toml:"JWT"`
-	Storage     StorageConfig  `mapstructure:"STORAGE" toml:"STORAGE"`
-	CORS        CORSConfig     `mapstructure:"CORS" toml:"CORS"`
+	Environment string           `mapstructure:"ENVIRONMENT" toml:"ENVIRONMENT"`
+	Server      ServerConfig     `mapstructure:"SERVER" toml:"SERVER"`
+	Database    DatabaseConfig   `mapstructure:"DATABASE" toml:"DATABASE"`
+	JWT         JWTConfig        `mapstructure:"JWT" toml:"JWT"`
+	Auth        AuthConfig       `mapstructure:"AUTH" toml:"AUTH"`
+	Storage     StorageConfig    `mapstructure:"STORAGE" toml:"STORAGE"`
+	CORS        CORSConfig       `mapstructure:"CORS" toml:"CORS"`
+	Scheduler   SchedulerConfig  `mapstructure:"SCHEDULER" toml:"SCHEDULER"`
+	Payoff      PayoffConfig     `mapstructure:"PAYOFF" toml:"PAYOFF"`
+	Scoring     ScoringConfig    `mapstructure:"SCORING" toml:"SCORING"`
+	Bureau      BureauConfig     `mapstructure:"CREDIT_BUREAU" toml:"CREDIT_BUREAU"`
+	Submission  SubmissionConfig `mapstructure:"SUBMISSION_CHECKS" toml:"SUBMISSION_CHECKS"`
 }
 
 type ServerConfig struct {
@@ -41,15 +50,48 @@ type DatabaseConfig struct {
 }
 
 type JWTConfig struct {
-	Secret      string `mapstructure:"SECRET" toml:"SECRET"`
-	ExpiryHours int    `mapstructure:"EXPIRY_HOURS" toml:"EXPIRY_HOURS"`
+	Secret             string `mapstructure:"SECRET" toml:"SECRET"`
+	Issuer             string `mapstructure:"ISSUER" toml:"ISSUER"`
+	ExpiryHours        int    `mapstructure:"EXPIRY_HOURS" toml:"EXPIRY_HOURS"`
+	RefreshExpiryHours int    `mapstructure:"REFRESH_EXPIRY_HOURS" toml:"REFRESH_EXPIRY_HOURS"`
+}
+
+type AuthConfig struct {
+	MaxFailedAttempts int `mapstructure:"MAX_FAILED_ATTEMPTS" toml:"MAX_FAILED_ATTEMPTS"`
+	LockoutMinutes    int `mapstructure:"LOCKOUT_MINUTES" toml:"LOCKOUT_MINUTES"`
+	// PermissionCacheSeconds bounds how long resolved role permissions are reused.
+	PermissionCacheSeconds int `mapstructure:"PERMISSION_CACHE_SECONDS" toml:"PERMISSION_CACHE_SECONDS"`
 }
 
 type StorageConfig struct {
-	UploadPath  string              `mapstructure:"UPLOAD_PATH" toml:"UPLOAD_PATH"`
-	MaxFileSize int64               `mapstructure:"MAX_FILE_SIZE" toml:"MAX_FILE_SIZE"`
-	PublicURL   string              `mapstructure:"PUBLIC_URL" toml:"PUBLIC_URL"`
-	Employees   StorageSubdirConfig `mapstructure:"EMPLOYEES" toml:"EMPLOYEES"`
+	// Provider is local (files under UPLOAD_PATH), s3 (S3-compatible bucket) or memory.
+	Provider    string `mapstructure:"PROVIDER" toml:"PROVIDER"`
+	UploadPath  string `mapstructure:"UPLOAD_PATH" toml:"UPLOAD_PATH"`
+	MaxFileSize int64  `mapstructure:"MAX_FILE_SIZE" toml:"MAX_FILE_SIZE"`
+	// PublicURL is where local and memory signed URLs are served back by this API ({BASE_PATH}/uploads).
+	PublicURL  string `mapstructure:"PUBLIC_URL" toml:"PUBLIC_URL"`
+	SigningKey string `mapstructure:"SIGNING_KEY" toml:"SIGNING_KEY"`
+	// SignedURLTTLSeconds bounds how long a signed URL stays valid.
+	SignedURLTTLSeconds int `mapstructure:"SIGNED_URL_TTL_SECONDS" toml:"SIGNED_URL_TTL_SECONDS"`
+	// SignedDocumentTypes are contract document types (KTP, KK) only read through signed URLs.
+	SignedDocumentTypes []string            `mapstructure:"SIGNED_DOCUMENT_TYPES" toml:"SIGNED_DOCUMENT_TYPES"`
+	S3                  S3StorageConfig     `mapstructure:"S3" toml:"S3"`
+	Employees           StorageSubdirConfig `mapstructure:"EMPLOYEES" toml:"EMPLOYEES"`
+	// ContractDocuments and MotorAssets validate uploads; the smaller of MAX_SIZE and MAX_FILE_SIZE applies.
+	ContractDocuments StorageSubdirConfig `mapstructure:"CONTRACT_DOCUMENTS" toml:"CONTRACT_DOCUMENTS"`
+	MotorAssets       StorageSubdirConfig `mapstructure:"MOTOR_ASSETS" toml:"MOTOR_ASSETS"`
+}
+
+// S3StorageConfig points at an S3-compatible bucket (AWS S3, MinIO).
+type S3StorageConfig struct {
+	Endpoint  string `mapstructure:"ENDPOINT" toml:"ENDPOINT"`
+	Region    string `mapstructure:"REGION" toml:"REGION"`
+	Bucket    string `mapstructure:"BUCKET" toml:"BUCKET"`
+	AccessKey string `mapstructure:"ACCESS_KEY" toml:"ACCESS_KEY"`
+	SecretKey string `mapstructure:"SECRET_KEY" toml:"SECRET_KEY"`
+	// UsePathStyle addresses objects as ENDPOINT/BUCKET/key instead of BUCKET.ENDPOINT/key.
+	UsePathStyle   bool `mapstructure:"USE_PATH_STYLE" toml:"USE_PATH_STYLE"`
+	TimeoutSeconds int  `mapstructure:"TIMEOUT_SECONDS" toml:"TIMEOUT_SECONDS"`
 }
 
 type AllowedTypesConfig struct {
@@ -70,6 +112,60 @@ type CORSConfig struct {
 	AllowCredentials bool     `mapstructure:"ALLOW_CREDENTIALS" toml:"ALLOW_CREDENTIALS"`
 }
 
+type SchedulerConfig struct {
+	Enabled bool `mapstructure:"ENABLED" toml:"ENABLED"`
+	// OverdueSweepInterval accepts a Go duration ("30m") or "@every 1h", "@hourly", "@daily".
+	OverdueSweepInterval string `mapstructure:"OVERDUE_SWEEP_INTERVAL" toml:"OVERDUE_SWEEP_INTERVAL"`
+}
+
+// PayoffConfig drives early payoff (pelunasan dipercepat) quotes.
+type PayoffConfig struct {
+	// RebatePolicy is one of none, full, percent, rule_of_78; it applies to margin of installments not yet due.
+	RebatePolicy  string  `mapstructure:"REBATE_POLICY" toml:"REBATE_POLICY"`
+	RebatePercent float64 `mapstructure:"REBATE_PERCENT" toml:"REBATE_PERCENT"`
+	FeePercent    float64 `mapstructure:"FEE_PERCENT" toml:"FEE_PERCENT"`
+	// FeeMin is in rupiah, e.g. 250000 or "250000.50".
+	FeeMin         money.Amount `mapstructure:"FEE_MIN" toml:"FEE_MIN"`
+	QuoteValidDays int          `mapstructure:"QUOTE_VALID_DAYS" toml:"QUOTE_VALID_DAYS"`
+}
+
+// ScoringConfig holds the thresholds of the rule-based credit scoring run by auto-scoring.
+type ScoringConfig struct {
+	// MaxDSRPercent rejects when all installments exceed this share of salary; ReviewDSRPercent only lowers the score.
+	MaxDSRPercent      float64      `mapstructure:"MAX_DSR_PERCENT" toml:"MAX_DSR_PERCENT"`
+	ReviewDSRPercent   float64      `mapstructure:"REVIEW_DSR_PERCENT" toml:"REVIEW_DSR_PERCENT"`
+	MinSalary          money.Amount `mapstructure:"MIN_SALARY" toml:"MIN_SALARY"`
+	MinAge             int          `mapstructure:"MIN_AGE" toml:"MIN_AGE"`
+	MaxAgeAtMaturity   int          `mapstructure:"MAX_AGE_AT_MATURITY" toml:"MAX_AGE_AT_MATURITY"`
+	MaxActiveContracts int          `mapstructure:"MAX_ACTIVE_CONTRACTS" toml:"MAX_ACTIVE_CONTRACTS"`
+	// ApproveScore and above approves; ReviewScore and above goes to manual review; lower rejects.
+	ApproveScore int      `mapstructure:"APPROVE_SCORE" toml:"APPROVE_SCORE"`
+	ReviewScore  int      `mapstructure:"REVIEW_SCORE" toml:"REVIEW_SCORE"`
+	HighRiskJobs []string `mapstructure:"HIGH_RISK_JOBS" toml:"HIGH_RISK_JOBS"`
+	// MaxKolektibilitas rejects a worse SLIK grade (1 lancar .. 5 macet); grades between 1 and it lower the score.
+	MaxKolektibilitas int `mapstructure:"MAX_KOLEKTIBILITAS" toml:"MAX_KOLEKTIBILITAS"`
+}
+
+// BureauConfig selects the credit bureau (SLIK OJK) client used by auto-scoring.
+type BureauConfig struct {
+	// Provider is none (default, skip the check), http, or fake (in-process, keyed by NIK; development only).
+	Provider       string `mapstructure:"PROVIDER" toml:"PROVIDER"`
+	BaseURL        string `mapstructure:"BASE_URL" toml:"BASE_URL"`
+	APIKey         string `mapstructure:"API_KEY" toml:"API_KEY"`
+	TimeoutSeconds int    `mapstructure:"TIMEOUT_SECONDS" toml:"TIMEOUT_SECONDS"`
+	// CacheDays reuses a customer's report for repeated applications within this many days.
+	CacheDays int `mapstructure:"CACHE_DAYS" toml:"CACHE_DAYS"`
+}
+
+// SubmissionConfig sets what each pre-submission check does on a hit: reject, review (flag the contract
+// for manual review) or off.
+type SubmissionConfig struct {
+	InFlightContract  string `mapstructure:"INFLIGHT_CONTRACT" toml:"INFLIGHT_CONTRACT"`
+	DuplicateIdentity string `mapstructure:"DUPLICATE_IDENTITY" toml:"DUPLICATE_IDENTITY"`
+	Repossessed       string `mapstructure:"REPOSSESSED" toml:"REPOSSESSED"`
+	Blacklist         string `mapstructure:"BLACKLIST" toml:"BLACKLIST"`
+}
+
 func loadConfig() (*Config, error) {
 	//buat default environment, bisa di override dengan env var ENVIRONMENT
 	env := "development"
@@ -98,7 +194,7 @@ func loadConfig() (*Config, error) {
 	}
 
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
+	if err := viper.Unmarshal(&config, viper.DecodeHook(decodeHook())); err != nil {
 		log.Printf("Error unmarshalling config: %v", err)
 		return nil, err
 	} else {
@@ -108,6 +204,38 @@ func loadConfig() (*Config, error) {
 	return &config, nil
 }
 
+// decodeHook keeps viper's default hooks and reads money.Amount settings as rupiah; without it mapstructure
+// would store a plain number as sen.
+func decodeHook() mapstructure.DecodeHookFunc {
+	return mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToWeakSliceHookFunc(","),
+		amountHookFunc(),
+	)
+}
+
+func amountHookFunc() mapstructure.DecodeHookFuncType {
+	amountType := reflect.TypeOf(money.Zero)
+	return func(from, to reflect.Type, data interface{}) (interface{}, error) {
+		if to != amountType {
+			return data, nil
+		}
+
+		value := reflect.ValueOf(data)
+		switch from.Kind() {
+		case reflect.String:
+			return money.Parse(value.String())
+		case reflect.Float32, reflect.Float64:
+			return money.FromFloat(value.Float()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return money.Rupiah(value.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return money.Rupiah(int64(value.Uint())), nil
+		}
+		return data, nil
+	}
+}
+
 func LoadConfig() (*Config, error) {
 	return loadConfig()
 }
@@ -139,18 +267,68 @@ func setDefaults() {
 	viper.SetDefault("DATABASE.MAX_IDLE_CONNS", 25)
 	viper.SetDefault("DATABASE.CONN_MAX_LIFETIME", 5)
 
-	viper.SetDefault("JWT.SECRET", "your-secret-key")
+	viper.SetDefault("JWT.SECRET", "")
+	viper.SetDefault("JWT.ISSUER", "honda-leasing-api")
 	viper.SetDefault("JWT.EXPIRY_HOURS", 24)
+	viper.SetDefault("JWT.REFRESH_EXPIRY_HOURS", 24*7)
 
+	viper.SetDefault("AUTH.MAX_FAILED_ATTEMPTS", 5)
+	viper.SetDefault("AUTH.LOCKOUT_MINUTES", 15)
+	viper.SetDefault("AUTH.PERMISSION_CACHE_SECONDS", 300)
+
+	viper.SetDefault("STORAGE.PROVIDER", "local")
 	viper.SetDefault("STORAGE.UPLOAD_PATH", "./uploads")
 	viper.SetDefault("STORAGE.MAX_FILE_SIZE", 10*1024*1024) // 10 MB
-	viper.SetDefault("STORAGE.PUBLIC_URL", "http://localhost:8080/uploads")
+	viper.SetDefault("STORAGE.PUBLIC_URL", "http://localhost:8080/leasing/api/uploads")
+	viper.SetDefault("STORAGE.SIGNING_KEY", "")
+	viper.SetDefault("STORAGE.SIGNED_URL_TTL_SECONDS", 300)
+	viper.SetDefault("STORAGE.SIGNED_DOCUMENT_TYPES", []string{"ktp", "kk"})
+	viper.SetDefault("STORAGE.S3.REGION", "us-east-1")
+	viper.SetDefault("STORAGE.S3.USE_PATH_STYLE", true)
+	viper.SetDefault("STORAGE.S3.TIMEOUT_SECONDS", 30)
 	viper.SetDefault("STORAGE.EMPLOYEES.MAX_SIZE", 5*1024*1024) // 5 MB
 	viper.SetDefault("STORAGE.EMPLOYEES.ALLOWED_TYPES", []string{"image/jpeg", "image/png"})
 	viper.SetDefault("STORAGE.EMPLOYEES.SUBDIRECTORY", "employees")
+	viper.SetDefault("STORAGE.CONTRACT_DOCUMENTS.MAX_SIZE", 5*1024*1024) // 5 MB
+	viper.SetDefault("STORAGE.CONTRACT_DOCUMENTS.ALLOWED_TYPES", []string{"image/jpeg", "image/png", "application/pdf"})
+	viper.SetDefault("STORAGE.CONTRACT_DOCUMENTS.SUBDIRECTORY", "contract_documents")
+	viper.SetDefault("STORAGE.MOTOR_ASSETS.MAX_SIZE", 5*1024*1024) // 5 MB
+	viper.SetDefault("STORAGE.MOTOR_ASSETS.ALLOWED_TYPES", []string{"image/jpeg", "image/png"})
+	viper.SetDefault("STORAGE.MOTOR_ASSETS.SUBDIRECTORY", "motor_assets")
 
 	viper.SetDefault("CORS.ALLOWED_ORIGINS", []string{"*"})
 	viper.SetDefault("CORS.ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE"})
 	viper.SetDefault("CORS.ALLOWED_HEADERS", []string{"Content-Type", "Authorization"})
 	viper.SetDefault("CORS.ALLOW_CREDENTIALS", true)
+
+	viper.SetDefault("SCHEDULER.ENABLED", true)
+	viper.SetDefault("SCHEDULER.OVERDUE_SWEEP_INTERVAL", "@hourly")
+
+	viper.SetDefault("PAYOFF.REBATE_POLICY", "rule_of_78")
+	viper.SetDefault("PAYOFF.REBATE_PERCENT", 0)
+	viper.SetDefault("PAYOFF.FEE_PERCENT", 1)
+	viper.SetDefault("PAYOFF.FEE_MIN", 0)
+	viper.SetDefault("PAYOFF.QUOTE_VALID_DAYS", 7)
+
+	viper.SetDefault("SCORING.MAX_DSR_PERCENT", 35)
+	viper.SetDefault("SCORING.REVIEW_DSR_PERCENT", 30)
+	viper.SetDefault("SCORING.MIN_SALARY", 3000000)
+	viper.SetDefault("SCORING.MIN_AGE", 21)
+	viper.SetDefault("SCORING.MAX_AGE_AT_MATURITY", 60)
+	viper.SetDefault("SCORING.MAX_ACTIVE_CONTRACTS", 2)
+	viper.SetDefault("SCORING.APPROVE_SCORE", 70)
+	viper.SetDefault("SCORING.REVIEW_SCORE", 50)
+	viper.SetDefault("SCORING.HIGH_RISK_JOBS", []string{"freelance", "pekerja lepas", "buruh harian", "ojek online"})
+	viper.SetDefault("SCORING.MAX_KOLEKTIBILITAS", 2)
+
+	viper.SetDefault("CREDIT_BUREAU.PROVIDER", "none")
+	viper.SetDefault("CREDIT_BUREAU.BASE_URL", "")
+	viper.SetDefault("CREDIT_BUREAU.API_KEY", "")
+	viper.SetDefault("CREDIT_BUREAU.TIMEOUT_SECONDS", 10)
+	viper.SetDefault("CREDIT_BUREAU.CACHE_DAYS", 30)
+
+	viper.SetDefault("SUBMISSION_CHECKS.INFLIGHT_CONTRACT", "reject")
+	viper.SetDefault("SUBMISSION_CHECKS.DUPLICATE_IDENTITY", "review")
+	viper.SetDefault("SUBMISSION_CHECKS.REPOSSESSED", "review")
+	viper.SetDefault("SUBMISSION_CHECKS.BLACKLIST", "reject")
 }
@@ -0,0 +1,55 @@
+package configs
+
+import (
+	"testing"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+func TestDecodeHookReadsAmountsAsRupiah(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  interface{}
+		want money.Amount
+	}{
+		{name: "toml integer", raw: int64(3000000), want: money.Rupiah(3000000)},
+		{name: "default int", raw: 0, want: money.Zero},
+		{name: "float", raw: 250000.5, want: money.Amount(25000050)},
+		{name: "string from env", raw: "1500000.25", want: money.Amount(150000025)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg ScoringConfig
+			decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+				DecodeHook:       decodeHook(),
+				WeaklyTypedInput: true,
+				Result:           &cfg,
+			})
+			if err != nil {
+				t.Fatalf("NewDecoder error: %v", err)
+			}
+			if err := decoder.Decode(map[string]interface{}{"MIN_SALARY": tt.raw}); err != nil {
+				t.Fatalf("Decode error: %v", err)
+			}
+			if cfg.MinSalary != tt.want {
+				t.Fatalf("MinSalary = %s, want %s", cfg.MinSalary, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeHookRejectsInvalidAmount(t *testing.T) {
+	var cfg PayoffConfig
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: decodeHook(),
+		Result:     &cfg,
+	})
+	if err != nil {
+		t.Fatalf("NewDecoder error: %v", err)
+	}
+	if err := decoder.Decode(map[string]interface{}{"FEE_MIN": "lima ribu"}); err == nil {
+		t.Fatalf("Decode accepted an invalid FEE_MIN, got %s", cfg.FeeMin)
+	}
+}
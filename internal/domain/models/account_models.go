@@ -15,6 +15,9 @@ type OAuthProvider struct {
 
 func (OAuthProvider) TableName() string { return "account.oauth_providers" }
 
+// SecretFields lists columns that are never serialized in API responses.
+func (OAuthProvider) SecretFields() []string { return []string{"client_secret"} }
+
 type User struct {
 	UserID             int64               `gorm:"column:user_id;primaryKey;autoIncrement"`
 	Username           string              `gorm:"column:username;size:50;not null;uniqueIndex"`
@@ -35,6 +38,8 @@ type User struct {
 
 func (User) TableName() string { return "account.users" }
 
+func (User) SecretFields() []string { return []string{"password", "pin_key"} }
+
 type UserOAuthProvider struct {
 	UserOAuthID  int64         `gorm:"column:user_oauth_id;primaryKey;autoIncrement"`
 	AccessToken  string        `gorm:"column:access_token;type:text;not null"`
@@ -49,6 +54,8 @@ type UserOAuthProvider struct {
 
 func (UserOAuthProvider) TableName() string { return "account.user_oauth_provider" }
 
+func (UserOAuthProvider) SecretFields() []string { return []string{"access_token", "refresh_token"} }
+
 type Role struct {
 	RoleID          int64            `gorm:"column:role_id;primaryKey;autoIncrement"`
 	RoleName        string           `gorm:"column:role_name;size:50;not null;uniqueIndex"`
@@ -89,3 +96,18 @@ type RolePermission struct {
 }
 
 func (RolePermission) TableName() string { return "account.role_permission" }
+
+type RefreshToken struct {
+	TokenID      int64      `gorm:"column:token_id;primaryKey;autoIncrement"`
+	TokenHash    string     `gorm:"column:token_hash;size:64;not null;uniqueIndex"`
+	ExpiresAt    time.Time  `gorm:"column:expires_at;type:timestamptz;not null"`
+	RevokedAt    *time.Time `gorm:"column:revoked_at;type:timestamptz"`
+	ReplacedByID *int64     `gorm:"column:replaced_by_id"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	UserID       int64      `gorm:"column:user_id;not null;index"`
+	User         User       `gorm:"foreignKey:UserID;references:UserID"`
+}
+
+func (RefreshToken) TableName() string { return "account.refresh_tokens" }
+
+func (RefreshToken) SecretFields() []string { return []string{"token_hash"} }
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type MotorType struct {
 	MotyID   int64   `gorm:"column:moty_id;primaryKey;autoIncrement"`
@@ -21,7 +25,7 @@ type Motor struct {
 	CCMesin      string       `gorm:"column:cc_mesin;size:30;not null"`
 	NomorPolisi  string       `gorm:"column:nomor_polisi;size:12;not null;uniqueIndex"`
 	StatusUnit   string       `gorm:"column:status_unit;size:20;not null"`
-	HargaOTR     float64      `gorm:"column:harga_otr;type:numeric(15,2);not null"`
+	HargaOTR     money.Amount `gorm:"column:harga_otr;type:numeric(15,2);not null"`
 	CreatedAt    time.Time    `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
 	MotorMotyID  int64        `gorm:"column:motor_moty_id;not null;index"`
 	MotorTypeRef MotorType    `gorm:"foreignKey:MotorMotyID;references:MotyID"`
@@ -36,6 +40,8 @@ type MotorAsset struct {
 	FileSize    float64 `gorm:"column:file_size;not null"`
 	FileType    string  `gorm:"column:file_type;size:15;not null"`
 	FileURL     string  `gorm:"column:file_url;size:125;not null"`
+	StoragePath *string `gorm:"column:storage_path;size:255"`
+	Checksum    *string `gorm:"column:checksum;size:64"`
 	MoasMotorID int64   `gorm:"column:moas_motor_id;not null;index"`
 	Motor       Motor   `gorm:"foreignKey:MoasMotorID;references:MotorID"`
 }
@@ -51,7 +57,7 @@ type Customer struct {
 	Email            string            `gorm:"column:email;size:100;not null;uniqueIndex"`
 	Pekerjaan        string            `gorm:"column:pekerjaan;size:80;not null"`
 	Perusahaan       *string           `gorm:"column:perusahaan;size:120"`
-	Salary           float64           `gorm:"column:salary;type:numeric(15,2);not null"`
+	Salary           money.Amount      `gorm:"column:salary;type:numeric(15,2);not null"`
 	CreatedAt        time.Time         `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
 	UpdatedAt        time.Time         `gorm:"column:updated_at;type:timestamptz;autoUpdateTime"`
 	LocationID       int64             `gorm:"column:location_id;not null;index"`
@@ -60,3 +66,31 @@ type Customer struct {
 }
 
 func (Customer) TableName() string { return "dealer.customer" }
+
+type CreditBureauReport struct {
+	ReportID         int64        `gorm:"column:report_id;primaryKey;autoIncrement"`
+	Provider         string       `gorm:"column:provider;size:30;not null"`
+	ReferenceID      *string      `gorm:"column:reference_id;size:60"`
+	Kolektibilitas   int16        `gorm:"column:kolektibilitas;not null"`
+	JumlahFasilitas  int          `gorm:"column:jumlah_fasilitas;not null;default:0"`
+	TotalOutstanding money.Amount `gorm:"column:total_outstanding;type:numeric(15,2);not null;default:0"`
+	CheckedAt        time.Time    `gorm:"column:checked_at;type:timestamptz;not null"`
+	ExpiresAt        time.Time    `gorm:"column:expires_at;type:timestamptz;not null"`
+	CustomerID       int64        `gorm:"column:customer_id;not null;index"`
+	Customer         Customer     `gorm:"foreignKey:CustomerID;references:CustomerID"`
+}
+
+func (CreditBureauReport) TableName() string { return "dealer.credit_bureau_reports" }
+
+type CustomerBlacklist struct {
+	BlacklistID int64      `gorm:"column:blacklist_id;primaryKey;autoIncrement"`
+	NIK         string     `gorm:"column:nik;size:16;not null;index"`
+	Reason      string     `gorm:"column:reason;size:255;not null"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at;type:timestamptz"`
+	CreatedAt   time.Time  `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;type:timestamptz;autoUpdateTime"`
+	CustomerID  *int64     `gorm:"column:customer_id;index"`
+	Customer    *Customer  `gorm:"foreignKey:CustomerID;references:CustomerID"`
+}
+
+func (CustomerBlacklist) TableName() string { return "dealer.customer_blacklist" }
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type LeasingProduct struct {
 	ProductID        int64             `gorm:"column:product_id;primaryKey;autoIncrement"`
@@ -10,14 +14,35 @@ type LeasingProduct struct {
 	DPPersenMin      float64           `gorm:"column:dp_persen_min;type:numeric(5,2);not null"`
 	DPPersenMax      float64           `gorm:"column:dp_persen_max;type:numeric(5,2);not null"`
 	BungaFlat        float64           `gorm:"column:bunga_flat;type:numeric(5,2);not null"`
-	AdminFee         float64           `gorm:"column:admin_fee;type:numeric(12,2);not null"`
+	MetodeBunga      string            `gorm:"column:metode_bunga;size:15;not null;default:flat"`
+	BungaEfektif     float64           `gorm:"column:bunga_efektif;type:numeric(5,2);not null;default:0"`
+	AdminFee         money.Amount      `gorm:"column:admin_fee;type:numeric(12,2);not null"`
+	AdminFeeDibiayai bool              `gorm:"column:admin_fee_dibiayai;not null;default:false"`
 	Asuransi         bool              `gorm:"column:asuransi;not null"`
+	AsuransiDibiayai bool              `gorm:"column:asuransi_dibiayai;not null;default:false"`
+	BiayaFidusia     money.Amount      `gorm:"column:biaya_fidusia;type:numeric(12,2);not null;default:0"`
+	FidusiaDibiayai  bool              `gorm:"column:fidusia_dibiayai;not null;default:false"`
+	DendaTipe        string            `gorm:"column:denda_tipe;size:15;not null;default:daily_rate"`
+	DendaNilai       float64           `gorm:"column:denda_nilai;type:numeric(12,4);not null;default:0"`
+	DendaGraceHari   int16             `gorm:"column:denda_grace_hari;not null;default:0"`
+	DendaMaks        money.Amount      `gorm:"column:denda_maks;type:numeric(15,2);not null;default:0"`
 	CreatedAt        time.Time         `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
 	LeasingContracts []LeasingContract `gorm:"foreignKey:ProductID;references:ProductID"`
 }
 
 func (LeasingProduct) TableName() string { return "leasing.leasing_product" }
 
+type InsuranceRate struct {
+	RateID     int64      `gorm:"column:rate_id;primaryKey;autoIncrement"`
+	TenorBulan int16      `gorm:"column:tenor_bulan;not null"`
+	RatePersen float64    `gorm:"column:rate_persen;type:numeric(6,3);not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	MotyID     *int64     `gorm:"column:moty_id;index"`
+	MotorType  *MotorType `gorm:"foreignKey:MotyID;references:MotyID"`
+}
+
+func (InsuranceRate) TableName() string { return "leasing.insurance_rates" }
+
 type LeasingContract struct {
 	ContractID        int64                     `gorm:"column:contract_id;primaryKey;autoIncrement"`
 	ContractNumber    *string                   `gorm:"column:contract_number;size:30;uniqueIndex"`
@@ -25,12 +50,23 @@ type LeasingContract struct {
 	TanggalAkad       *time.Time                `gorm:"column:tanggal_akad;type:date"`
 	TanggalMulaiCicil time.Time                 `gorm:"column:tanggal_mulai_cicil;type:date;not null"`
 	TenorBulan        int16                     `gorm:"column:tenor_bulan;not null"`
-	NilaiKendaraan    float64                   `gorm:"column:nilai_kendaraan;type:numeric(15,2);not null"`
-	DPDibayar         float64                   `gorm:"column:dp_dibayar;type:numeric(15,2);not null"`
-	PokokPinjaman     float64                   `gorm:"column:pokok_pinjaman;type:numeric(15,2);not null"`
-	TotalPinjaman     float64                   `gorm:"column:total_pinjaman;type:numeric(15,2);not null"`
-	CicilanPerBulan   float64                   `gorm:"column:cicilan_per_bulan;type:numeric(15,2);not null"`
+	NilaiKendaraan    money.Amount              `gorm:"column:nilai_kendaraan;type:numeric(15,2);not null"`
+	DPDibayar         money.Amount              `gorm:"column:dp_dibayar;type:numeric(15,2);not null"`
+	PokokPinjaman     money.Amount              `gorm:"column:pokok_pinjaman;type:numeric(15,2);not null"`
+	TotalPinjaman     money.Amount              `gorm:"column:total_pinjaman;type:numeric(15,2);not null"`
+	CicilanPerBulan   money.Amount              `gorm:"column:cicilan_per_bulan;type:numeric(15,2);not null"`
+	MetodeBunga       string                    `gorm:"column:metode_bunga;size:15;not null;default:flat"`
+	BungaFlat         float64                   `gorm:"column:bunga_flat;type:numeric(5,2);not null;default:0"`
+	BungaEfektif      float64                   `gorm:"column:bunga_efektif;type:numeric(5,2);not null;default:0"`
+	SaldoKredit       money.Amount              `gorm:"column:saldo_kredit;type:numeric(15,2);not null;default:0"`
+	AdminFee          money.Amount              `gorm:"column:admin_fee;type:numeric(15,2);not null;default:0"`
+	AsuransiPremi     money.Amount              `gorm:"column:asuransi_premi;type:numeric(15,2);not null;default:0"`
+	BiayaFidusia      money.Amount              `gorm:"column:biaya_fidusia;type:numeric(15,2);not null;default:0"`
+	BiayaDibiayai     money.Amount              `gorm:"column:biaya_dibiayai;type:numeric(15,2);not null;default:0"`
+	BiayaDimuka       money.Amount              `gorm:"column:biaya_dimuka;type:numeric(15,2);not null;default:0"`
 	Status            string                    `gorm:"column:status;size:20;not null"`
+	ManualReview      bool                      `gorm:"column:manual_review;not null;default:false"`
+	ReviewReasons     *string                   `gorm:"column:review_reasons;size:255"`
 	CreatedAt         time.Time                 `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
 	UpdatedAt         time.Time                 `gorm:"column:updated_at;type:timestamptz;autoUpdateTime"`
 	CustomerID        int64                     `gorm:"column:customer_id;not null;index"`
@@ -43,13 +79,28 @@ type LeasingContract struct {
 	PaymentSchedules  []PaymentSchedule         `gorm:"foreignKey:ContractID;references:ContractID"`
 	Payments          []Payment                 `gorm:"foreignKey:ContractID;references:ContractID"`
 	ContractDocuments []LeasingContractDocument `gorm:"foreignKey:ContractID;references:ContractID"`
+	StatusHistory     []ContractStatusHistory   `gorm:"foreignKey:ContractID;references:ContractID"`
 }
 
 func (LeasingContract) TableName() string { return "leasing.leasing_contract" }
 
+type ContractStatusHistory struct {
+	HistoryID  int64           `gorm:"column:history_id;primaryKey;autoIncrement"`
+	FromStatus *string         `gorm:"column:from_status;size:20"`
+	ToStatus   string          `gorm:"column:to_status;size:20;not null"`
+	Reason     string          `gorm:"column:reason;type:text"`
+	ActorID    *int64          `gorm:"column:actor_id;index"`
+	CreatedAt  time.Time       `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	ContractID int64           `gorm:"column:contract_id;not null;index"`
+	Contract   LeasingContract `gorm:"foreignKey:ContractID;references:ContractID"`
+}
+
+func (ContractStatusHistory) TableName() string { return "leasing.contract_status_history" }
+
 type LeasingTask struct {
 	TaskID           int64                  `gorm:"column:task_id;primaryKey;autoIncrement"`
 	TaskName         string                 `gorm:"column:task_name;size:85;not null"`
+	TaskCode         *string                `gorm:"column:task_code;size:30;index"`
 	StartDate        time.Time              `gorm:"column:startdate;type:date;not null"`
 	EndDate          time.Time              `gorm:"column:enddate;type:date;not null"`
 	ActualStartDate  *time.Time             `gorm:"column:actual_startdate;type:date"`
@@ -58,6 +109,8 @@ type LeasingTask struct {
 	Status           string                 `gorm:"column:status;size:15;not null"`
 	ContractID       int64                  `gorm:"column:contract_id;not null;index"`
 	RoleID           int64                  `gorm:"column:role_id;not null;index"`
+	CompletedBy      *int64                 `gorm:"column:completed_by"`
+	CompletedAt      *time.Time             `gorm:"column:completed_at;type:timestamptz"`
 	Contract         LeasingContract        `gorm:"foreignKey:ContractID;references:ContractID"`
 	Role             Role                   `gorm:"foreignKey:RoleID;references:RoleID"`
 	LeasingAttribute []LeasingTaskAttribute `gorm:"foreignKey:TasaLetaID;references:TaskID"`
@@ -77,13 +130,16 @@ type LeasingTaskAttribute struct {
 func (LeasingTaskAttribute) TableName() string { return "leasing.leasing_tasks_attributes" }
 
 type LeasingContractDocument struct {
-	LocID      int64           `gorm:"column:loc_id;primaryKey;autoIncrement"`
-	FileName   string          `gorm:"column:file_name;size:125;not null"`
-	FileSize   float64         `gorm:"column:file_size;not null"`
-	FileType   string          `gorm:"column:file_type;size:15;not null"`
-	FileURL    string          `gorm:"column:file_url;size:125;not null"`
-	ContractID int64           `gorm:"column:contract_id;not null;index"`
-	Contract   LeasingContract `gorm:"foreignKey:ContractID;references:ContractID"`
+	LocID       int64           `gorm:"column:loc_id;primaryKey;autoIncrement"`
+	FileName    string          `gorm:"column:file_name;size:125;not null"`
+	FileSize    float64         `gorm:"column:file_size;not null"`
+	FileType    string          `gorm:"column:file_type;size:15;not null"`
+	FileURL     string          `gorm:"column:file_url;size:125;not null"`
+	DocType     string          `gorm:"column:doc_type;size:15;not null;default:other"`
+	StoragePath *string         `gorm:"column:storage_path;size:255"`
+	Checksum    *string         `gorm:"column:checksum;size:64"`
+	ContractID  int64           `gorm:"column:contract_id;not null;index"`
+	Contract    LeasingContract `gorm:"foreignKey:ContractID;references:ContractID"`
 }
 
 func (LeasingContractDocument) TableName() string { return "leasing.leasing_contract_documents" }
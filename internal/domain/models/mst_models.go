@@ -53,6 +53,7 @@ func (Location) TableName() string { return "mst.locations" }
 type TemplateTask struct {
 	TetaID     int64                   `gorm:"column:teta_id;primaryKey;autoIncrement"`
 	TetaName   string                  `gorm:"column:teta_name;size:85;not null"`
+	TetaCode   *string                 `gorm:"column:teta_code;size:30;uniqueIndex"`
 	TetaRoleID int64                   `gorm:"column:teta_role_id;not null;index"`
 	Role       Role                    `gorm:"foreignKey:TetaRoleID;references:RoleID"`
 	Attributes []TemplateTaskAttribute `gorm:"foreignKey:TetatTetaID;references:TetaID"`
@@ -1,14 +1,21 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type PaymentSchedule struct {
 	ScheduleID       int64           `gorm:"column:schedule_id;primaryKey;autoIncrement"`
 	AngsuranKe       int16           `gorm:"column:angsuran_ke;not null"`
 	JatuhTempo       time.Time       `gorm:"column:jatuh_tempo;type:date;not null"`
-	Pokok            float64         `gorm:"column:pokok;type:numeric(15,2);not null"`
-	Margin           float64         `gorm:"column:margin;type:numeric(15,2);not null"`
-	TotalTagihan     float64         `gorm:"column:total_tagihan;type:numeric(15,2);not null"`
+	Pokok            money.Amount    `gorm:"column:pokok;type:numeric(15,2);not null"`
+	Margin           money.Amount    `gorm:"column:margin;type:numeric(15,2);not null"`
+	TotalTagihan     money.Amount    `gorm:"column:total_tagihan;type:numeric(15,2);not null"`
+	Terbayar         money.Amount    `gorm:"column:terbayar;type:numeric(15,2);not null;default:0"`
+	Denda            money.Amount    `gorm:"column:denda;type:numeric(15,2);not null;default:0"`
+	DendaTerbayar    money.Amount    `gorm:"column:denda_terbayar;type:numeric(15,2);not null;default:0"`
 	StatusPembayaran string          `gorm:"column:status_pembayaran;size:20;not null"`
 	TanggalBayar     *time.Time      `gorm:"column:tanggal_bayar;type:date"`
 	CreatedAt        time.Time       `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
@@ -20,17 +27,57 @@ type PaymentSchedule struct {
 func (PaymentSchedule) TableName() string { return "payment.payment_schedule" }
 
 type Payment struct {
-	PaymentID        int64            `gorm:"column:payment_id;primaryKey;autoIncrement"`
-	NomorBukti       string           `gorm:"column:nomor_bukti;size:40;not null;uniqueIndex"`
-	JumlahBayar      float64          `gorm:"column:jumlah_bayar;type:numeric(15,2);not null"`
-	TanggalBayar     time.Time        `gorm:"column:tanggal_bayar;type:date;not null"`
-	MetodePembayaran string           `gorm:"column:metode_pembayaran;size:30;not null"`
-	Provider         string           `gorm:"column:provider;size:50;not null"`
-	CreatedAt        time.Time        `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
-	ContractID       int64            `gorm:"column:contract_id;not null;index"`
-	ScheduleID       *int64           `gorm:"column:schedule_id;index"`
-	Contract         LeasingContract  `gorm:"foreignKey:ContractID;references:ContractID"`
-	Schedule         *PaymentSchedule `gorm:"foreignKey:ScheduleID;references:ScheduleID"`
+	PaymentID        int64               `gorm:"column:payment_id;primaryKey;autoIncrement"`
+	NomorBukti       string              `gorm:"column:nomor_bukti;size:40;not null;uniqueIndex"`
+	JumlahBayar      money.Amount        `gorm:"column:jumlah_bayar;type:numeric(15,2);not null"`
+	TanggalBayar     time.Time           `gorm:"column:tanggal_bayar;type:date;not null"`
+	MetodePembayaran string              `gorm:"column:metode_pembayaran;size:30;not null"`
+	Provider         string              `gorm:"column:provider;size:50;not null"`
+	CreatedAt        time.Time           `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	ContractID       int64               `gorm:"column:contract_id;not null;index"`
+	ScheduleID       *int64              `gorm:"column:schedule_id;index"`
+	Contract         LeasingContract     `gorm:"foreignKey:ContractID;references:ContractID"`
+	Schedule         *PaymentSchedule    `gorm:"foreignKey:ScheduleID;references:ScheduleID"`
+	Allocations      []PaymentAllocation `gorm:"foreignKey:PaymentID;references:PaymentID"`
 }
 
 func (Payment) TableName() string { return "payment.payments" }
+
+// PaymentAllocation is one line of how a payment was spent: an installment, or credit held/consumed.
+type PaymentAllocation struct {
+	AllocationID int64            `gorm:"column:allocation_id;primaryKey;autoIncrement"`
+	Kind         string           `gorm:"column:kind;size:20;not null"`
+	Amount       money.Amount     `gorm:"column:amount;type:numeric(15,2);not null"`
+	CreatedAt    time.Time        `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	PaymentID    int64            `gorm:"column:payment_id;not null;index"`
+	ScheduleID   *int64           `gorm:"column:schedule_id;index"`
+	Payment      Payment          `gorm:"foreignKey:PaymentID;references:PaymentID"`
+	Schedule     *PaymentSchedule `gorm:"foreignKey:ScheduleID;references:ScheduleID"`
+}
+
+func (PaymentAllocation) TableName() string { return "payment.payment_allocations" }
+
+// PayoffQuote is an issued early payoff quote; a payoff is only accepted against a stored quote within ValidUntil.
+type PayoffQuote struct {
+	QuoteID               int64           `gorm:"column:quote_id;primaryKey;autoIncrement"`
+	QuoteDate             time.Time       `gorm:"column:quote_date;type:date;not null"`
+	ValidUntil            time.Time       `gorm:"column:valid_until;type:date;not null"`
+	RebatePolicy          string          `gorm:"column:rebate_policy;size:20;not null"`
+	RemainingInstallments int             `gorm:"column:remaining_installments;not null"`
+	RemainingPrincipal    money.Amount    `gorm:"column:remaining_principal;type:numeric(15,2);not null"`
+	OutstandingMargin     money.Amount    `gorm:"column:outstanding_margin;type:numeric(15,2);not null"`
+	UnearnedMargin        money.Amount    `gorm:"column:unearned_margin;type:numeric(15,2);not null"`
+	MarginRebate          money.Amount    `gorm:"column:margin_rebate;type:numeric(15,2);not null"`
+	Penalties             money.Amount    `gorm:"column:penalties;type:numeric(15,2);not null"`
+	Fee                   money.Amount    `gorm:"column:fee;type:numeric(15,2);not null"`
+	CreditBalance         money.Amount    `gorm:"column:credit_balance;type:numeric(15,2);not null"`
+	PayoffAmount          money.Amount    `gorm:"column:payoff_amount;type:numeric(15,2);not null"`
+	SettledAt             *time.Time      `gorm:"column:settled_at;type:timestamptz"`
+	CreatedAt             time.Time       `gorm:"column:created_at;type:timestamptz;autoCreateTime"`
+	ContractID            int64           `gorm:"column:contract_id;not null;index"`
+	PaymentID             *int64          `gorm:"column:payment_id"`
+	Contract              LeasingContract `gorm:"foreignKey:ContractID;references:ContractID"`
+	Payment               *Payment        `gorm:"foreignKey:PaymentID;references:PaymentID"`
+}
+
+func (PayoffQuote) TableName() string { return "payment.payoff_quotes" }
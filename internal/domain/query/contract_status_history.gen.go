@@ -0,0 +1,1201 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newContractStatusHistory(db *gorm.DB, opts ...gen.DOOption) contractStatusHistory {
+	_contractStatusHistory := contractStatusHistory{}
+
+	_contractStatusHistory.contractStatusHistoryDo.UseDB(db, opts...)
+	_contractStatusHistory.contractStatusHistoryDo.UseModel(&models.ContractStatusHistory{})
+
+	tableName := _contractStatusHistory.contractStatusHistoryDo.TableName()
+	_contractStatusHistory.ALL = field.NewAsterisk(tableName)
+	_contractStatusHistory.HistoryID = field.NewInt64(tableName, "history_id")
+	_contractStatusHistory.FromStatus = field.NewString(tableName, "from_status")
+	_contractStatusHistory.ToStatus = field.NewString(tableName, "to_status")
+	_contractStatusHistory.Reason = field.NewString(tableName, "reason")
+	_contractStatusHistory.ActorID = field.NewInt64(tableName, "actor_id")
+	_contractStatusHistory.CreatedAt = field.NewTime(tableName, "created_at")
+	_contractStatusHistory.ContractID = field.NewInt64(tableName, "contract_id")
+	_contractStatusHistory.Contract = contractStatusHistoryHasOneContract{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Contract", "models.LeasingContract"),
+		Customer: struct {
+			field.RelationField
+			Location struct {
+				field.RelationField
+				Kelurahan struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}
+			}
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Customer", "models.Customer"),
+			Location: struct {
+				field.RelationField
+				Kelurahan struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Customer.Location", "models.Location"),
+				Kelurahan: struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan", "models.Kelurahan"),
+					Kecamatan: struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan", "models.Kecamatan"),
+						Kabupaten: struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten", "models.Kabupaten"),
+							Province: struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}{
+								RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province", "models.Province"),
+								Kabupaten: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province.Kabupaten", "models.Kabupaten"),
+								},
+							},
+							Kecamatan: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Kecamatan", "models.Kecamatan"),
+							},
+						},
+						Kelurahan: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kelurahan", "models.Kelurahan"),
+						},
+					},
+					Locations: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Locations", "models.Location"),
+					},
+				},
+			},
+			LeasingContracts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.Customer.LeasingContracts", "models.LeasingContract"),
+			},
+		},
+		Motor: struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Motor", "models.Motor"),
+			MotorTypeRef: struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Motor.MotorTypeRef", "models.MotorType"),
+				Motors: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.Motor.MotorTypeRef.Motors", "models.Motor"),
+				},
+			},
+			MotorAssets: struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Motor.MotorAssets", "models.MotorAsset"),
+				Motor: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.Motor.MotorAssets.Motor", "models.Motor"),
+				},
+			},
+		},
+		Product: struct {
+			field.RelationField
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Product", "models.LeasingProduct"),
+			LeasingContracts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.Product.LeasingContracts", "models.LeasingContract"),
+			},
+		},
+		LeasingTasks: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			LeasingAttribute struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.LeasingTasks", "models.LeasingTask"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.Contract", "models.LeasingContract"),
+			},
+			Role: struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.Role", "models.Role"),
+				UserRoles: struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles", "models.UserRole"),
+					User: struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User", "models.User"),
+						UserOAuthProviders: struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders", "models.UserOAuthProvider"),
+							User: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.User", "models.User"),
+							},
+							Provider: struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}{
+								RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+								UserOAuthProviders: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+								},
+							},
+						},
+						UserRoles: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserRoles", "models.UserRole"),
+						},
+					},
+					Role: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.Role", "models.Role"),
+					},
+				},
+				RolePermissions: struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions", "models.RolePermission"),
+					Role: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Role", "models.Role"),
+					},
+					Permission: struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Permission", "models.Permission"),
+						RolePermissions: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+						},
+					},
+				},
+			},
+			LeasingAttribute: struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.LeasingAttribute", "models.LeasingTaskAttribute"),
+				Task: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.LeasingAttribute.Task", "models.LeasingTask"),
+				},
+			},
+		},
+		PaymentSchedules: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Payments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.PaymentSchedules.Contract", "models.LeasingContract"),
+			},
+			Payments: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Contract", "models.LeasingContract"),
+				},
+				Schedule: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
+				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
+			},
+		},
+		Payments: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Contract.Payments", "models.Payment"),
+		},
+		ContractDocuments: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.ContractDocuments", "models.LeasingContractDocument"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
+			},
+		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
+	}
+
+	_contractStatusHistory.fillFieldMap()
+
+	return _contractStatusHistory
+}
+
+type contractStatusHistory struct {
+	contractStatusHistoryDo
+
+	ALL        field.Asterisk
+	HistoryID  field.Int64
+	FromStatus field.String
+	ToStatus   field.String
+	Reason     field.String
+	ActorID    field.Int64
+	CreatedAt  field.Time
+	ContractID field.Int64
+	Contract   contractStatusHistoryHasOneContract
+
+	fieldMap map[string]field.Expr
+}
+
+func (c contractStatusHistory) Table(newTableName string) *contractStatusHistory {
+	c.contractStatusHistoryDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c contractStatusHistory) As(alias string) *contractStatusHistory {
+	c.contractStatusHistoryDo.DO = *(c.contractStatusHistoryDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *contractStatusHistory) updateTableName(table string) *contractStatusHistory {
+	c.ALL = field.NewAsterisk(table)
+	c.HistoryID = field.NewInt64(table, "history_id")
+	c.FromStatus = field.NewString(table, "from_status")
+	c.ToStatus = field.NewString(table, "to_status")
+	c.Reason = field.NewString(table, "reason")
+	c.ActorID = field.NewInt64(table, "actor_id")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.ContractID = field.NewInt64(table, "contract_id")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *contractStatusHistory) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *contractStatusHistory) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap["history_id"] = c.HistoryID
+	c.fieldMap["from_status"] = c.FromStatus
+	c.fieldMap["to_status"] = c.ToStatus
+	c.fieldMap["reason"] = c.Reason
+	c.fieldMap["actor_id"] = c.ActorID
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["contract_id"] = c.ContractID
+
+}
+
+func (c contractStatusHistory) clone(db *gorm.DB) contractStatusHistory {
+	c.contractStatusHistoryDo.ReplaceConnPool(db.Statement.ConnPool)
+	c.Contract.db = db.Session(&gorm.Session{Initialized: true})
+	c.Contract.db.Statement.ConnPool = db.Statement.ConnPool
+	return c
+}
+
+func (c contractStatusHistory) replaceDB(db *gorm.DB) contractStatusHistory {
+	c.contractStatusHistoryDo.ReplaceDB(db)
+	c.Contract.db = db.Session(&gorm.Session{})
+	return c
+}
+
+type contractStatusHistoryHasOneContract struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Customer struct {
+		field.RelationField
+		Location struct {
+			field.RelationField
+			Kelurahan struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}
+		}
+		LeasingContracts struct {
+			field.RelationField
+		}
+	}
+	Motor struct {
+		field.RelationField
+		MotorTypeRef struct {
+			field.RelationField
+			Motors struct {
+				field.RelationField
+			}
+		}
+		MotorAssets struct {
+			field.RelationField
+			Motor struct {
+				field.RelationField
+			}
+		}
+	}
+	Product struct {
+		field.RelationField
+		LeasingContracts struct {
+			field.RelationField
+		}
+	}
+	LeasingTasks struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+		Role struct {
+			field.RelationField
+			UserRoles struct {
+				field.RelationField
+				User struct {
+					field.RelationField
+					UserOAuthProviders struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+						Provider struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+							}
+						}
+					}
+					UserRoles struct {
+						field.RelationField
+					}
+				}
+				Role struct {
+					field.RelationField
+				}
+			}
+			RolePermissions struct {
+				field.RelationField
+				Role struct {
+					field.RelationField
+				}
+				Permission struct {
+					field.RelationField
+					RolePermissions struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		LeasingAttribute struct {
+			field.RelationField
+			Task struct {
+				field.RelationField
+			}
+		}
+	}
+	PaymentSchedules struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+		Payments struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Schedule struct {
+				field.RelationField
+			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
+		}
+	}
+	Payments struct {
+		field.RelationField
+	}
+	ContractDocuments struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a contractStatusHistoryHasOneContract) Where(conds ...field.Expr) *contractStatusHistoryHasOneContract {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a contractStatusHistoryHasOneContract) WithContext(ctx context.Context) *contractStatusHistoryHasOneContract {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a contractStatusHistoryHasOneContract) Session(session *gorm.Session) *contractStatusHistoryHasOneContract {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a contractStatusHistoryHasOneContract) Model(m *models.ContractStatusHistory) *contractStatusHistoryHasOneContractTx {
+	return &contractStatusHistoryHasOneContractTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a contractStatusHistoryHasOneContract) Unscoped() *contractStatusHistoryHasOneContract {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type contractStatusHistoryHasOneContractTx struct{ tx *gorm.Association }
+
+func (a contractStatusHistoryHasOneContractTx) Find() (result *models.LeasingContract, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a contractStatusHistoryHasOneContractTx) Append(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a contractStatusHistoryHasOneContractTx) Replace(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a contractStatusHistoryHasOneContractTx) Delete(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a contractStatusHistoryHasOneContractTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a contractStatusHistoryHasOneContractTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a contractStatusHistoryHasOneContractTx) Unscoped() *contractStatusHistoryHasOneContractTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type contractStatusHistoryDo struct{ gen.DO }
+
+type IContractStatusHistoryDo interface {
+	gen.SubQuery
+	Debug() IContractStatusHistoryDo
+	WithContext(ctx context.Context) IContractStatusHistoryDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IContractStatusHistoryDo
+	WriteDB() IContractStatusHistoryDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IContractStatusHistoryDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IContractStatusHistoryDo
+	Not(conds ...gen.Condition) IContractStatusHistoryDo
+	Or(conds ...gen.Condition) IContractStatusHistoryDo
+	Select(conds ...field.Expr) IContractStatusHistoryDo
+	Where(conds ...gen.Condition) IContractStatusHistoryDo
+	Order(conds ...field.Expr) IContractStatusHistoryDo
+	Distinct(cols ...field.Expr) IContractStatusHistoryDo
+	Omit(cols ...field.Expr) IContractStatusHistoryDo
+	Join(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo
+	Group(cols ...field.Expr) IContractStatusHistoryDo
+	Having(conds ...gen.Condition) IContractStatusHistoryDo
+	Limit(limit int) IContractStatusHistoryDo
+	Offset(offset int) IContractStatusHistoryDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IContractStatusHistoryDo
+	Unscoped() IContractStatusHistoryDo
+	Create(values ...*models.ContractStatusHistory) error
+	CreateInBatches(values []*models.ContractStatusHistory, batchSize int) error
+	Save(values ...*models.ContractStatusHistory) error
+	First() (*models.ContractStatusHistory, error)
+	Take() (*models.ContractStatusHistory, error)
+	Last() (*models.ContractStatusHistory, error)
+	Find() ([]*models.ContractStatusHistory, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ContractStatusHistory, err error)
+	FindInBatches(result *[]*models.ContractStatusHistory, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.ContractStatusHistory) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IContractStatusHistoryDo
+	Assign(attrs ...field.AssignExpr) IContractStatusHistoryDo
+	Joins(fields ...field.RelationField) IContractStatusHistoryDo
+	Preload(fields ...field.RelationField) IContractStatusHistoryDo
+	FirstOrInit() (*models.ContractStatusHistory, error)
+	FirstOrCreate() (*models.ContractStatusHistory, error)
+	FindByPage(offset int, limit int) (result []*models.ContractStatusHistory, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IContractStatusHistoryDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c contractStatusHistoryDo) Debug() IContractStatusHistoryDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c contractStatusHistoryDo) WithContext(ctx context.Context) IContractStatusHistoryDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c contractStatusHistoryDo) ReadDB() IContractStatusHistoryDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c contractStatusHistoryDo) WriteDB() IContractStatusHistoryDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c contractStatusHistoryDo) Session(config *gorm.Session) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c contractStatusHistoryDo) Clauses(conds ...clause.Expression) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c contractStatusHistoryDo) Returning(value interface{}, columns ...string) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c contractStatusHistoryDo) Not(conds ...gen.Condition) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c contractStatusHistoryDo) Or(conds ...gen.Condition) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c contractStatusHistoryDo) Select(conds ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c contractStatusHistoryDo) Where(conds ...gen.Condition) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c contractStatusHistoryDo) Order(conds ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c contractStatusHistoryDo) Distinct(cols ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c contractStatusHistoryDo) Omit(cols ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c contractStatusHistoryDo) Join(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c contractStatusHistoryDo) LeftJoin(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c contractStatusHistoryDo) RightJoin(table schema.Tabler, on ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c contractStatusHistoryDo) Group(cols ...field.Expr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c contractStatusHistoryDo) Having(conds ...gen.Condition) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c contractStatusHistoryDo) Limit(limit int) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c contractStatusHistoryDo) Offset(offset int) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c contractStatusHistoryDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c contractStatusHistoryDo) Unscoped() IContractStatusHistoryDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c contractStatusHistoryDo) Create(values ...*models.ContractStatusHistory) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c contractStatusHistoryDo) CreateInBatches(values []*models.ContractStatusHistory, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c contractStatusHistoryDo) Save(values ...*models.ContractStatusHistory) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c contractStatusHistoryDo) First() (*models.ContractStatusHistory, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ContractStatusHistory), nil
+	}
+}
+
+func (c contractStatusHistoryDo) Take() (*models.ContractStatusHistory, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ContractStatusHistory), nil
+	}
+}
+
+func (c contractStatusHistoryDo) Last() (*models.ContractStatusHistory, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ContractStatusHistory), nil
+	}
+}
+
+func (c contractStatusHistoryDo) Find() ([]*models.ContractStatusHistory, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.ContractStatusHistory), err
+}
+
+func (c contractStatusHistoryDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ContractStatusHistory, err error) {
+	buf := make([]*models.ContractStatusHistory, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c contractStatusHistoryDo) FindInBatches(result *[]*models.ContractStatusHistory, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c contractStatusHistoryDo) Attrs(attrs ...field.AssignExpr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c contractStatusHistoryDo) Assign(attrs ...field.AssignExpr) IContractStatusHistoryDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c contractStatusHistoryDo) Joins(fields ...field.RelationField) IContractStatusHistoryDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c contractStatusHistoryDo) Preload(fields ...field.RelationField) IContractStatusHistoryDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c contractStatusHistoryDo) FirstOrInit() (*models.ContractStatusHistory, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ContractStatusHistory), nil
+	}
+}
+
+func (c contractStatusHistoryDo) FirstOrCreate() (*models.ContractStatusHistory, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ContractStatusHistory), nil
+	}
+}
+
+func (c contractStatusHistoryDo) FindByPage(offset int, limit int) (result []*models.ContractStatusHistory, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c contractStatusHistoryDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c contractStatusHistoryDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c contractStatusHistoryDo) Delete(models ...*models.ContractStatusHistory) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *contractStatusHistoryDo) withDO(do gen.Dao) *contractStatusHistoryDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -0,0 +1,1293 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newCreditBureauReport(db *gorm.DB, opts ...gen.DOOption) creditBureauReport {
+	_creditBureauReport := creditBureauReport{}
+
+	_creditBureauReport.creditBureauReportDo.UseDB(db, opts...)
+	_creditBureauReport.creditBureauReportDo.UseModel(&models.CreditBureauReport{})
+
+	tableName := _creditBureauReport.creditBureauReportDo.TableName()
+	_creditBureauReport.ALL = field.NewAsterisk(tableName)
+	_creditBureauReport.ReportID = field.NewInt64(tableName, "report_id")
+	_creditBureauReport.Provider = field.NewString(tableName, "provider")
+	_creditBureauReport.ReferenceID = field.NewString(tableName, "reference_id")
+	_creditBureauReport.Kolektibilitas = field.NewInt16(tableName, "kolektibilitas")
+	_creditBureauReport.JumlahFasilitas = field.NewInt(tableName, "jumlah_fasilitas")
+	_creditBureauReport.TotalOutstanding = field.NewField(tableName, "total_outstanding")
+	_creditBureauReport.CheckedAt = field.NewTime(tableName, "checked_at")
+	_creditBureauReport.ExpiresAt = field.NewTime(tableName, "expires_at")
+	_creditBureauReport.CustomerID = field.NewInt64(tableName, "customer_id")
+	_creditBureauReport.Customer = creditBureauReportHasOneCustomer{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Customer", "models.Customer"),
+		Location: struct {
+			field.RelationField
+			Kelurahan struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Customer.Location", "models.Location"),
+			Kelurahan: struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.Location.Kelurahan", "models.Kelurahan"),
+				Kecamatan: struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan", "models.Kecamatan"),
+					Kabupaten: struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten", "models.Kabupaten"),
+						Province: struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province", "models.Province"),
+							Kabupaten: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province.Kabupaten", "models.Kabupaten"),
+							},
+						},
+						Kecamatan: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Kecamatan", "models.Kecamatan"),
+						},
+					},
+					Kelurahan: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kelurahan", "models.Kelurahan"),
+					},
+				},
+				Locations: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.Location.Kelurahan.Locations", "models.Location"),
+				},
+			},
+		},
+		LeasingContracts: struct {
+			field.RelationField
+			Customer struct {
+				field.RelationField
+			}
+			Motor struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}
+			Product struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}
+			LeasingTasks struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}
+			PaymentSchedules struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Payments struct {
+				field.RelationField
+			}
+			ContractDocuments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+			StatusHistory struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Customer.LeasingContracts", "models.LeasingContract"),
+			Customer: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Customer", "models.Customer"),
+			},
+			Motor: struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Motor", "models.Motor"),
+				MotorTypeRef: struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorTypeRef", "models.MotorType"),
+					Motors: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorTypeRef.Motors", "models.Motor"),
+					},
+				},
+				MotorAssets: struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorAssets", "models.MotorAsset"),
+					Motor: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorAssets.Motor", "models.Motor"),
+					},
+				},
+			},
+			Product: struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Product", "models.LeasingProduct"),
+				LeasingContracts: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Product.LeasingContracts", "models.LeasingContract"),
+				},
+			},
+			LeasingTasks: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks", "models.LeasingTask"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Contract", "models.LeasingContract"),
+				},
+				Role: struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role", "models.Role"),
+					UserRoles: struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles", "models.UserRole"),
+						User: struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User", "models.User"),
+							UserOAuthProviders: struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders", "models.UserOAuthProvider"),
+								User: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.User", "models.User"),
+								},
+								Provider: struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}{
+									RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+									UserOAuthProviders: struct {
+										field.RelationField
+									}{
+										RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+									},
+								},
+							},
+							UserRoles: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserRoles", "models.UserRole"),
+							},
+						},
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.Role", "models.Role"),
+						},
+					},
+					RolePermissions: struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions", "models.RolePermission"),
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Role", "models.Role"),
+						},
+						Permission: struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Permission", "models.Permission"),
+							RolePermissions: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+							},
+						},
+					},
+				},
+				LeasingAttribute: struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.LeasingAttribute", "models.LeasingTaskAttribute"),
+					Task: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.LeasingAttribute.Task", "models.LeasingTask"),
+					},
+				},
+			},
+			PaymentSchedules: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules", "models.PaymentSchedule"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Contract", "models.LeasingContract"),
+				},
+				Payments: struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments", "models.Payment"),
+					Contract: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Contract", "models.LeasingContract"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
+					},
+					Allocations: struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+						Payment: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+						},
+						Schedule: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+						},
+					},
+				},
+			},
+			Payments: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Payments", "models.Payment"),
+			},
+			ContractDocuments: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.ContractDocuments", "models.LeasingContractDocument"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.ContractDocuments.Contract", "models.LeasingContract"),
+				},
+			},
+			StatusHistory: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory", "models.ContractStatusHistory"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory.Contract", "models.LeasingContract"),
+				},
+			},
+		},
+	}
+
+	_creditBureauReport.fillFieldMap()
+
+	return _creditBureauReport
+}
+
+type creditBureauReport struct {
+	creditBureauReportDo
+
+	ALL              field.Asterisk
+	ReportID         field.Int64
+	Provider         field.String
+	ReferenceID      field.String
+	Kolektibilitas   field.Int16
+	JumlahFasilitas  field.Int
+	TotalOutstanding field.Field
+	CheckedAt        field.Time
+	ExpiresAt        field.Time
+	CustomerID       field.Int64
+	Customer         creditBureauReportHasOneCustomer
+
+	fieldMap map[string]field.Expr
+}
+
+func (c creditBureauReport) Table(newTableName string) *creditBureauReport {
+	c.creditBureauReportDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c creditBureauReport) As(alias string) *creditBureauReport {
+	c.creditBureauReportDo.DO = *(c.creditBureauReportDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *creditBureauReport) updateTableName(table string) *creditBureauReport {
+	c.ALL = field.NewAsterisk(table)
+	c.ReportID = field.NewInt64(table, "report_id")
+	c.Provider = field.NewString(table, "provider")
+	c.ReferenceID = field.NewString(table, "reference_id")
+	c.Kolektibilitas = field.NewInt16(table, "kolektibilitas")
+	c.JumlahFasilitas = field.NewInt(table, "jumlah_fasilitas")
+	c.TotalOutstanding = field.NewField(table, "total_outstanding")
+	c.CheckedAt = field.NewTime(table, "checked_at")
+	c.ExpiresAt = field.NewTime(table, "expires_at")
+	c.CustomerID = field.NewInt64(table, "customer_id")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *creditBureauReport) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *creditBureauReport) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 10)
+	c.fieldMap["report_id"] = c.ReportID
+	c.fieldMap["provider"] = c.Provider
+	c.fieldMap["reference_id"] = c.ReferenceID
+	c.fieldMap["kolektibilitas"] = c.Kolektibilitas
+	c.fieldMap["jumlah_fasilitas"] = c.JumlahFasilitas
+	c.fieldMap["total_outstanding"] = c.TotalOutstanding
+	c.fieldMap["checked_at"] = c.CheckedAt
+	c.fieldMap["expires_at"] = c.ExpiresAt
+	c.fieldMap["customer_id"] = c.CustomerID
+
+}
+
+func (c creditBureauReport) clone(db *gorm.DB) creditBureauReport {
+	c.creditBureauReportDo.ReplaceConnPool(db.Statement.ConnPool)
+	c.Customer.db = db.Session(&gorm.Session{Initialized: true})
+	c.Customer.db.Statement.ConnPool = db.Statement.ConnPool
+	return c
+}
+
+func (c creditBureauReport) replaceDB(db *gorm.DB) creditBureauReport {
+	c.creditBureauReportDo.ReplaceDB(db)
+	c.Customer.db = db.Session(&gorm.Session{})
+	return c
+}
+
+type creditBureauReportHasOneCustomer struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Location struct {
+		field.RelationField
+		Kelurahan struct {
+			field.RelationField
+			Kecamatan struct {
+				field.RelationField
+				Kabupaten struct {
+					field.RelationField
+					Province struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+						}
+					}
+					Kecamatan struct {
+						field.RelationField
+					}
+				}
+				Kelurahan struct {
+					field.RelationField
+				}
+			}
+			Locations struct {
+				field.RelationField
+			}
+		}
+	}
+	LeasingContracts struct {
+		field.RelationField
+		Customer struct {
+			field.RelationField
+		}
+		Motor struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}
+		Product struct {
+			field.RelationField
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}
+		LeasingTasks struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			LeasingAttribute struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}
+		}
+		PaymentSchedules struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Payments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Payments struct {
+			field.RelationField
+		}
+		ContractDocuments struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+		StatusHistory struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a creditBureauReportHasOneCustomer) Where(conds ...field.Expr) *creditBureauReportHasOneCustomer {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a creditBureauReportHasOneCustomer) WithContext(ctx context.Context) *creditBureauReportHasOneCustomer {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a creditBureauReportHasOneCustomer) Session(session *gorm.Session) *creditBureauReportHasOneCustomer {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a creditBureauReportHasOneCustomer) Model(m *models.CreditBureauReport) *creditBureauReportHasOneCustomerTx {
+	return &creditBureauReportHasOneCustomerTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a creditBureauReportHasOneCustomer) Unscoped() *creditBureauReportHasOneCustomer {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type creditBureauReportHasOneCustomerTx struct{ tx *gorm.Association }
+
+func (a creditBureauReportHasOneCustomerTx) Find() (result *models.Customer, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a creditBureauReportHasOneCustomerTx) Append(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a creditBureauReportHasOneCustomerTx) Replace(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a creditBureauReportHasOneCustomerTx) Delete(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a creditBureauReportHasOneCustomerTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a creditBureauReportHasOneCustomerTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a creditBureauReportHasOneCustomerTx) Unscoped() *creditBureauReportHasOneCustomerTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type creditBureauReportDo struct{ gen.DO }
+
+type ICreditBureauReportDo interface {
+	gen.SubQuery
+	Debug() ICreditBureauReportDo
+	WithContext(ctx context.Context) ICreditBureauReportDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICreditBureauReportDo
+	WriteDB() ICreditBureauReportDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICreditBureauReportDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICreditBureauReportDo
+	Not(conds ...gen.Condition) ICreditBureauReportDo
+	Or(conds ...gen.Condition) ICreditBureauReportDo
+	Select(conds ...field.Expr) ICreditBureauReportDo
+	Where(conds ...gen.Condition) ICreditBureauReportDo
+	Order(conds ...field.Expr) ICreditBureauReportDo
+	Distinct(cols ...field.Expr) ICreditBureauReportDo
+	Omit(cols ...field.Expr) ICreditBureauReportDo
+	Join(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo
+	Group(cols ...field.Expr) ICreditBureauReportDo
+	Having(conds ...gen.Condition) ICreditBureauReportDo
+	Limit(limit int) ICreditBureauReportDo
+	Offset(offset int) ICreditBureauReportDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICreditBureauReportDo
+	Unscoped() ICreditBureauReportDo
+	Create(values ...*models.CreditBureauReport) error
+	CreateInBatches(values []*models.CreditBureauReport, batchSize int) error
+	Save(values ...*models.CreditBureauReport) error
+	First() (*models.CreditBureauReport, error)
+	Take() (*models.CreditBureauReport, error)
+	Last() (*models.CreditBureauReport, error)
+	Find() ([]*models.CreditBureauReport, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CreditBureauReport, err error)
+	FindInBatches(result *[]*models.CreditBureauReport, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.CreditBureauReport) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICreditBureauReportDo
+	Assign(attrs ...field.AssignExpr) ICreditBureauReportDo
+	Joins(fields ...field.RelationField) ICreditBureauReportDo
+	Preload(fields ...field.RelationField) ICreditBureauReportDo
+	FirstOrInit() (*models.CreditBureauReport, error)
+	FirstOrCreate() (*models.CreditBureauReport, error)
+	FindByPage(offset int, limit int) (result []*models.CreditBureauReport, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICreditBureauReportDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c creditBureauReportDo) Debug() ICreditBureauReportDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c creditBureauReportDo) WithContext(ctx context.Context) ICreditBureauReportDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c creditBureauReportDo) ReadDB() ICreditBureauReportDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c creditBureauReportDo) WriteDB() ICreditBureauReportDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c creditBureauReportDo) Session(config *gorm.Session) ICreditBureauReportDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c creditBureauReportDo) Clauses(conds ...clause.Expression) ICreditBureauReportDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c creditBureauReportDo) Returning(value interface{}, columns ...string) ICreditBureauReportDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c creditBureauReportDo) Not(conds ...gen.Condition) ICreditBureauReportDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c creditBureauReportDo) Or(conds ...gen.Condition) ICreditBureauReportDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c creditBureauReportDo) Select(conds ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c creditBureauReportDo) Where(conds ...gen.Condition) ICreditBureauReportDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c creditBureauReportDo) Order(conds ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c creditBureauReportDo) Distinct(cols ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c creditBureauReportDo) Omit(cols ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c creditBureauReportDo) Join(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c creditBureauReportDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c creditBureauReportDo) RightJoin(table schema.Tabler, on ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c creditBureauReportDo) Group(cols ...field.Expr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c creditBureauReportDo) Having(conds ...gen.Condition) ICreditBureauReportDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c creditBureauReportDo) Limit(limit int) ICreditBureauReportDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c creditBureauReportDo) Offset(offset int) ICreditBureauReportDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c creditBureauReportDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICreditBureauReportDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c creditBureauReportDo) Unscoped() ICreditBureauReportDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c creditBureauReportDo) Create(values ...*models.CreditBureauReport) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c creditBureauReportDo) CreateInBatches(values []*models.CreditBureauReport, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c creditBureauReportDo) Save(values ...*models.CreditBureauReport) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c creditBureauReportDo) First() (*models.CreditBureauReport, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CreditBureauReport), nil
+	}
+}
+
+func (c creditBureauReportDo) Take() (*models.CreditBureauReport, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CreditBureauReport), nil
+	}
+}
+
+func (c creditBureauReportDo) Last() (*models.CreditBureauReport, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CreditBureauReport), nil
+	}
+}
+
+func (c creditBureauReportDo) Find() ([]*models.CreditBureauReport, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.CreditBureauReport), err
+}
+
+func (c creditBureauReportDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CreditBureauReport, err error) {
+	buf := make([]*models.CreditBureauReport, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c creditBureauReportDo) FindInBatches(result *[]*models.CreditBureauReport, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c creditBureauReportDo) Attrs(attrs ...field.AssignExpr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c creditBureauReportDo) Assign(attrs ...field.AssignExpr) ICreditBureauReportDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c creditBureauReportDo) Joins(fields ...field.RelationField) ICreditBureauReportDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c creditBureauReportDo) Preload(fields ...field.RelationField) ICreditBureauReportDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c creditBureauReportDo) FirstOrInit() (*models.CreditBureauReport, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CreditBureauReport), nil
+	}
+}
+
+func (c creditBureauReportDo) FirstOrCreate() (*models.CreditBureauReport, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CreditBureauReport), nil
+	}
+}
+
+func (c creditBureauReportDo) FindByPage(offset int, limit int) (result []*models.CreditBureauReport, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c creditBureauReportDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c creditBureauReportDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c creditBureauReportDo) Delete(models ...*models.CreditBureauReport) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *creditBureauReportDo) withDO(do gen.Dao) *creditBureauReportDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
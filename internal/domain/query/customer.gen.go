@@ -36,7 +36,7 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 	_customer.Email = field.NewString(tableName, "email")
 	_customer.Pekerjaan = field.NewString(tableName, "pekerjaan")
 	_customer.Perusahaan = field.NewString(tableName, "perusahaan")
-	_customer.Salary = field.NewFloat64(tableName, "salary")
+	_customer.Salary = field.NewField(tableName, "salary")
 	_customer.CreatedAt = field.NewTime(tableName, "created_at")
 	_customer.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_customer.LocationID = field.NewInt64(tableName, "location_id")
@@ -460,6 +460,15 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("LeasingContracts.PaymentSchedules", "models.PaymentSchedule"),
@@ -476,6 +485,15 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -488,6 +506,27 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 				}{
 					RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -508,6 +547,19 @@ func newCustomer(db *gorm.DB, opts ...gen.DOOption) customer {
 				RelationField: field.NewRelation("LeasingContracts.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("LeasingContracts.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("LeasingContracts.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_customer.fillFieldMap()
@@ -527,7 +579,7 @@ type customer struct {
 	Email        field.String
 	Pekerjaan    field.String
 	Perusahaan   field.String
-	Salary       field.Float64
+	Salary       field.Field
 	CreatedAt    field.Time
 	UpdatedAt    field.Time
 	LocationID   field.Int64
@@ -558,7 +610,7 @@ func (c *customer) updateTableName(table string) *customer {
 	c.Email = field.NewString(table, "email")
 	c.Pekerjaan = field.NewString(table, "pekerjaan")
 	c.Perusahaan = field.NewString(table, "perusahaan")
-	c.Salary = field.NewFloat64(table, "salary")
+	c.Salary = field.NewField(table, "salary")
 	c.CreatedAt = field.NewTime(table, "created_at")
 	c.UpdatedAt = field.NewTime(table, "updated_at")
 	c.LocationID = field.NewInt64(table, "location_id")
@@ -815,6 +867,15 @@ type customerHasManyLeasingContracts struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -826,6 +887,12 @@ type customerHasManyLeasingContracts struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a customerHasManyLeasingContracts) Where(conds ...field.Expr) *customerHasManyLeasingContracts {
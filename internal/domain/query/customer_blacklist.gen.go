@@ -0,0 +1,1285 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newCustomerBlacklist(db *gorm.DB, opts ...gen.DOOption) customerBlacklist {
+	_customerBlacklist := customerBlacklist{}
+
+	_customerBlacklist.customerBlacklistDo.UseDB(db, opts...)
+	_customerBlacklist.customerBlacklistDo.UseModel(&models.CustomerBlacklist{})
+
+	tableName := _customerBlacklist.customerBlacklistDo.TableName()
+	_customerBlacklist.ALL = field.NewAsterisk(tableName)
+	_customerBlacklist.BlacklistID = field.NewInt64(tableName, "blacklist_id")
+	_customerBlacklist.NIK = field.NewString(tableName, "nik")
+	_customerBlacklist.Reason = field.NewString(tableName, "reason")
+	_customerBlacklist.ExpiresAt = field.NewTime(tableName, "expires_at")
+	_customerBlacklist.CreatedAt = field.NewTime(tableName, "created_at")
+	_customerBlacklist.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_customerBlacklist.CustomerID = field.NewInt64(tableName, "customer_id")
+	_customerBlacklist.Customer = customerBlacklistHasOneCustomer{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Customer", "models.Customer"),
+		Location: struct {
+			field.RelationField
+			Kelurahan struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Customer.Location", "models.Location"),
+			Kelurahan: struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.Location.Kelurahan", "models.Kelurahan"),
+				Kecamatan: struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan", "models.Kecamatan"),
+					Kabupaten: struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten", "models.Kabupaten"),
+						Province: struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province", "models.Province"),
+							Kabupaten: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province.Kabupaten", "models.Kabupaten"),
+							},
+						},
+						Kecamatan: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kabupaten.Kecamatan", "models.Kecamatan"),
+						},
+					},
+					Kelurahan: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.Location.Kelurahan.Kecamatan.Kelurahan", "models.Kelurahan"),
+					},
+				},
+				Locations: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.Location.Kelurahan.Locations", "models.Location"),
+				},
+			},
+		},
+		LeasingContracts: struct {
+			field.RelationField
+			Customer struct {
+				field.RelationField
+			}
+			Motor struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}
+			Product struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}
+			LeasingTasks struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}
+			PaymentSchedules struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Payments struct {
+				field.RelationField
+			}
+			ContractDocuments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+			StatusHistory struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Customer.LeasingContracts", "models.LeasingContract"),
+			Customer: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Customer", "models.Customer"),
+			},
+			Motor: struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Motor", "models.Motor"),
+				MotorTypeRef: struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorTypeRef", "models.MotorType"),
+					Motors: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorTypeRef.Motors", "models.Motor"),
+					},
+				},
+				MotorAssets: struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorAssets", "models.MotorAsset"),
+					Motor: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.Motor.MotorAssets.Motor", "models.Motor"),
+					},
+				},
+			},
+			Product: struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Product", "models.LeasingProduct"),
+				LeasingContracts: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.Product.LeasingContracts", "models.LeasingContract"),
+				},
+			},
+			LeasingTasks: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks", "models.LeasingTask"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Contract", "models.LeasingContract"),
+				},
+				Role: struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role", "models.Role"),
+					UserRoles: struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles", "models.UserRole"),
+						User: struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User", "models.User"),
+							UserOAuthProviders: struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders", "models.UserOAuthProvider"),
+								User: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.User", "models.User"),
+								},
+								Provider: struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}{
+									RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+									UserOAuthProviders: struct {
+										field.RelationField
+									}{
+										RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+									},
+								},
+							},
+							UserRoles: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.User.UserRoles", "models.UserRole"),
+							},
+						},
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.UserRoles.Role", "models.Role"),
+						},
+					},
+					RolePermissions: struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions", "models.RolePermission"),
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Role", "models.Role"),
+						},
+						Permission: struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Permission", "models.Permission"),
+							RolePermissions: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+							},
+						},
+					},
+				},
+				LeasingAttribute: struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.LeasingAttribute", "models.LeasingTaskAttribute"),
+					Task: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.LeasingTasks.LeasingAttribute.Task", "models.LeasingTask"),
+					},
+				},
+			},
+			PaymentSchedules: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules", "models.PaymentSchedule"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Contract", "models.LeasingContract"),
+				},
+				Payments: struct {
+					field.RelationField
+					Contract struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments", "models.Payment"),
+					Contract: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Contract", "models.LeasingContract"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
+					},
+					Allocations: struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+						Payment: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+						},
+						Schedule: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+						},
+					},
+				},
+			},
+			Payments: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.Payments", "models.Payment"),
+			},
+			ContractDocuments: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.ContractDocuments", "models.LeasingContractDocument"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.ContractDocuments.Contract", "models.LeasingContract"),
+				},
+			},
+			StatusHistory: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory", "models.ContractStatusHistory"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory.Contract", "models.LeasingContract"),
+				},
+			},
+		},
+	}
+
+	_customerBlacklist.fillFieldMap()
+
+	return _customerBlacklist
+}
+
+type customerBlacklist struct {
+	customerBlacklistDo
+
+	ALL         field.Asterisk
+	BlacklistID field.Int64
+	NIK         field.String
+	Reason      field.String
+	ExpiresAt   field.Time
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+	CustomerID  field.Int64
+	Customer    customerBlacklistHasOneCustomer
+
+	fieldMap map[string]field.Expr
+}
+
+func (c customerBlacklist) Table(newTableName string) *customerBlacklist {
+	c.customerBlacklistDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c customerBlacklist) As(alias string) *customerBlacklist {
+	c.customerBlacklistDo.DO = *(c.customerBlacklistDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *customerBlacklist) updateTableName(table string) *customerBlacklist {
+	c.ALL = field.NewAsterisk(table)
+	c.BlacklistID = field.NewInt64(table, "blacklist_id")
+	c.NIK = field.NewString(table, "nik")
+	c.Reason = field.NewString(table, "reason")
+	c.ExpiresAt = field.NewTime(table, "expires_at")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+	c.CustomerID = field.NewInt64(table, "customer_id")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *customerBlacklist) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *customerBlacklist) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap["blacklist_id"] = c.BlacklistID
+	c.fieldMap["nik"] = c.NIK
+	c.fieldMap["reason"] = c.Reason
+	c.fieldMap["expires_at"] = c.ExpiresAt
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+	c.fieldMap["customer_id"] = c.CustomerID
+
+}
+
+func (c customerBlacklist) clone(db *gorm.DB) customerBlacklist {
+	c.customerBlacklistDo.ReplaceConnPool(db.Statement.ConnPool)
+	c.Customer.db = db.Session(&gorm.Session{Initialized: true})
+	c.Customer.db.Statement.ConnPool = db.Statement.ConnPool
+	return c
+}
+
+func (c customerBlacklist) replaceDB(db *gorm.DB) customerBlacklist {
+	c.customerBlacklistDo.ReplaceDB(db)
+	c.Customer.db = db.Session(&gorm.Session{})
+	return c
+}
+
+type customerBlacklistHasOneCustomer struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Location struct {
+		field.RelationField
+		Kelurahan struct {
+			field.RelationField
+			Kecamatan struct {
+				field.RelationField
+				Kabupaten struct {
+					field.RelationField
+					Province struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+						}
+					}
+					Kecamatan struct {
+						field.RelationField
+					}
+				}
+				Kelurahan struct {
+					field.RelationField
+				}
+			}
+			Locations struct {
+				field.RelationField
+			}
+		}
+	}
+	LeasingContracts struct {
+		field.RelationField
+		Customer struct {
+			field.RelationField
+		}
+		Motor struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}
+		Product struct {
+			field.RelationField
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}
+		LeasingTasks struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			LeasingAttribute struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}
+		}
+		PaymentSchedules struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Payments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Payments struct {
+			field.RelationField
+		}
+		ContractDocuments struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+		StatusHistory struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a customerBlacklistHasOneCustomer) Where(conds ...field.Expr) *customerBlacklistHasOneCustomer {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a customerBlacklistHasOneCustomer) WithContext(ctx context.Context) *customerBlacklistHasOneCustomer {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a customerBlacklistHasOneCustomer) Session(session *gorm.Session) *customerBlacklistHasOneCustomer {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a customerBlacklistHasOneCustomer) Model(m *models.CustomerBlacklist) *customerBlacklistHasOneCustomerTx {
+	return &customerBlacklistHasOneCustomerTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a customerBlacklistHasOneCustomer) Unscoped() *customerBlacklistHasOneCustomer {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type customerBlacklistHasOneCustomerTx struct{ tx *gorm.Association }
+
+func (a customerBlacklistHasOneCustomerTx) Find() (result *models.Customer, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a customerBlacklistHasOneCustomerTx) Append(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a customerBlacklistHasOneCustomerTx) Replace(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a customerBlacklistHasOneCustomerTx) Delete(values ...*models.Customer) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a customerBlacklistHasOneCustomerTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a customerBlacklistHasOneCustomerTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a customerBlacklistHasOneCustomerTx) Unscoped() *customerBlacklistHasOneCustomerTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type customerBlacklistDo struct{ gen.DO }
+
+type ICustomerBlacklistDo interface {
+	gen.SubQuery
+	Debug() ICustomerBlacklistDo
+	WithContext(ctx context.Context) ICustomerBlacklistDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICustomerBlacklistDo
+	WriteDB() ICustomerBlacklistDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICustomerBlacklistDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICustomerBlacklistDo
+	Not(conds ...gen.Condition) ICustomerBlacklistDo
+	Or(conds ...gen.Condition) ICustomerBlacklistDo
+	Select(conds ...field.Expr) ICustomerBlacklistDo
+	Where(conds ...gen.Condition) ICustomerBlacklistDo
+	Order(conds ...field.Expr) ICustomerBlacklistDo
+	Distinct(cols ...field.Expr) ICustomerBlacklistDo
+	Omit(cols ...field.Expr) ICustomerBlacklistDo
+	Join(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo
+	Group(cols ...field.Expr) ICustomerBlacklistDo
+	Having(conds ...gen.Condition) ICustomerBlacklistDo
+	Limit(limit int) ICustomerBlacklistDo
+	Offset(offset int) ICustomerBlacklistDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICustomerBlacklistDo
+	Unscoped() ICustomerBlacklistDo
+	Create(values ...*models.CustomerBlacklist) error
+	CreateInBatches(values []*models.CustomerBlacklist, batchSize int) error
+	Save(values ...*models.CustomerBlacklist) error
+	First() (*models.CustomerBlacklist, error)
+	Take() (*models.CustomerBlacklist, error)
+	Last() (*models.CustomerBlacklist, error)
+	Find() ([]*models.CustomerBlacklist, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CustomerBlacklist, err error)
+	FindInBatches(result *[]*models.CustomerBlacklist, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.CustomerBlacklist) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICustomerBlacklistDo
+	Assign(attrs ...field.AssignExpr) ICustomerBlacklistDo
+	Joins(fields ...field.RelationField) ICustomerBlacklistDo
+	Preload(fields ...field.RelationField) ICustomerBlacklistDo
+	FirstOrInit() (*models.CustomerBlacklist, error)
+	FirstOrCreate() (*models.CustomerBlacklist, error)
+	FindByPage(offset int, limit int) (result []*models.CustomerBlacklist, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICustomerBlacklistDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c customerBlacklistDo) Debug() ICustomerBlacklistDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c customerBlacklistDo) WithContext(ctx context.Context) ICustomerBlacklistDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c customerBlacklistDo) ReadDB() ICustomerBlacklistDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c customerBlacklistDo) WriteDB() ICustomerBlacklistDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c customerBlacklistDo) Session(config *gorm.Session) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c customerBlacklistDo) Clauses(conds ...clause.Expression) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c customerBlacklistDo) Returning(value interface{}, columns ...string) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c customerBlacklistDo) Not(conds ...gen.Condition) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c customerBlacklistDo) Or(conds ...gen.Condition) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c customerBlacklistDo) Select(conds ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c customerBlacklistDo) Where(conds ...gen.Condition) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c customerBlacklistDo) Order(conds ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c customerBlacklistDo) Distinct(cols ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c customerBlacklistDo) Omit(cols ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c customerBlacklistDo) Join(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c customerBlacklistDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c customerBlacklistDo) RightJoin(table schema.Tabler, on ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c customerBlacklistDo) Group(cols ...field.Expr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c customerBlacklistDo) Having(conds ...gen.Condition) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c customerBlacklistDo) Limit(limit int) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c customerBlacklistDo) Offset(offset int) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c customerBlacklistDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c customerBlacklistDo) Unscoped() ICustomerBlacklistDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c customerBlacklistDo) Create(values ...*models.CustomerBlacklist) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c customerBlacklistDo) CreateInBatches(values []*models.CustomerBlacklist, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c customerBlacklistDo) Save(values ...*models.CustomerBlacklist) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c customerBlacklistDo) First() (*models.CustomerBlacklist, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CustomerBlacklist), nil
+	}
+}
+
+func (c customerBlacklistDo) Take() (*models.CustomerBlacklist, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CustomerBlacklist), nil
+	}
+}
+
+func (c customerBlacklistDo) Last() (*models.CustomerBlacklist, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CustomerBlacklist), nil
+	}
+}
+
+func (c customerBlacklistDo) Find() ([]*models.CustomerBlacklist, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.CustomerBlacklist), err
+}
+
+func (c customerBlacklistDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CustomerBlacklist, err error) {
+	buf := make([]*models.CustomerBlacklist, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c customerBlacklistDo) FindInBatches(result *[]*models.CustomerBlacklist, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c customerBlacklistDo) Attrs(attrs ...field.AssignExpr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c customerBlacklistDo) Assign(attrs ...field.AssignExpr) ICustomerBlacklistDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c customerBlacklistDo) Joins(fields ...field.RelationField) ICustomerBlacklistDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c customerBlacklistDo) Preload(fields ...field.RelationField) ICustomerBlacklistDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c customerBlacklistDo) FirstOrInit() (*models.CustomerBlacklist, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CustomerBlacklist), nil
+	}
+}
+
+func (c customerBlacklistDo) FirstOrCreate() (*models.CustomerBlacklist, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CustomerBlacklist), nil
+	}
+}
+
+func (c customerBlacklistDo) FindByPage(offset int, limit int) (result []*models.CustomerBlacklist, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c customerBlacklistDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c customerBlacklistDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c customerBlacklistDo) Delete(models ...*models.CustomerBlacklist) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *customerBlacklistDo) withDO(do gen.Dao) *customerBlacklistDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
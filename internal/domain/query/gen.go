@@ -17,7 +17,11 @@ import (
 
 var (
 	Q                       = new(Query)
+	ContractStatusHistory   *contractStatusHistory
+	CreditBureauReport      *creditBureauReport
 	Customer                *customer
+	CustomerBlacklist       *customerBlacklist
+	InsuranceRate           *insuranceRate
 	Kabupaten               *kabupaten
 	Kecamatan               *kecamatan
 	Kelurahan               *kelurahan
@@ -32,9 +36,12 @@ var (
 	MotorType               *motorType
 	OAuthProvider           *oAuthProvider
 	Payment                 *payment
+	PaymentAllocation       *paymentAllocation
 	PaymentSchedule         *paymentSchedule
+	PayoffQuote             *payoffQuote
 	Permission              *permission
 	Province                *province
+	RefreshToken            *refreshToken
 	Role                    *role
 	RolePermission          *rolePermission
 	TemplateTask            *templateTask
@@ -46,7 +53,11 @@ var (
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	ContractStatusHistory = &Q.ContractStatusHistory
+	CreditBureauReport = &Q.CreditBureauReport
 	Customer = &Q.Customer
+	CustomerBlacklist = &Q.CustomerBlacklist
+	InsuranceRate = &Q.InsuranceRate
 	Kabupaten = &Q.Kabupaten
 	Kecamatan = &Q.Kecamatan
 	Kelurahan = &Q.Kelurahan
@@ -61,9 +72,12 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	MotorType = &Q.MotorType
 	OAuthProvider = &Q.OAuthProvider
 	Payment = &Q.Payment
+	PaymentAllocation = &Q.PaymentAllocation
 	PaymentSchedule = &Q.PaymentSchedule
+	PayoffQuote = &Q.PayoffQuote
 	Permission = &Q.Permission
 	Province = &Q.Province
+	RefreshToken = &Q.RefreshToken
 	Role = &Q.Role
 	RolePermission = &Q.RolePermission
 	TemplateTask = &Q.TemplateTask
@@ -76,7 +90,11 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                      db,
+		ContractStatusHistory:   newContractStatusHistory(db, opts...),
+		CreditBureauReport:      newCreditBureauReport(db, opts...),
 		Customer:                newCustomer(db, opts...),
+		CustomerBlacklist:       newCustomerBlacklist(db, opts...),
+		InsuranceRate:           newInsuranceRate(db, opts...),
 		Kabupaten:               newKabupaten(db, opts...),
 		Kecamatan:               newKecamatan(db, opts...),
 		Kelurahan:               newKelurahan(db, opts...),
@@ -91,9 +109,12 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		MotorType:               newMotorType(db, opts...),
 		OAuthProvider:           newOAuthProvider(db, opts...),
 		Payment:                 newPayment(db, opts...),
+		PaymentAllocation:       newPaymentAllocation(db, opts...),
 		PaymentSchedule:         newPaymentSchedule(db, opts...),
+		PayoffQuote:             newPayoffQuote(db, opts...),
 		Permission:              newPermission(db, opts...),
 		Province:                newProvince(db, opts...),
+		RefreshToken:            newRefreshToken(db, opts...),
 		Role:                    newRole(db, opts...),
 		RolePermission:          newRolePermission(db, opts...),
 		TemplateTask:            newTemplateTask(db, opts...),
@@ -107,7 +128,11 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	ContractStatusHistory   contractStatusHistory
+	CreditBureauReport      creditBureauReport
 	Customer                customer
+	CustomerBlacklist       customerBlacklist
+	InsuranceRate           insuranceRate
 	Kabupaten               kabupaten
 	Kecamatan               kecamatan
 	Kelurahan               kelurahan
@@ -122,9 +147,12 @@ type Query struct {
 	MotorType               motorType
 	OAuthProvider           oAuthProvider
 	Payment                 payment
+	PaymentAllocation       paymentAllocation
 	PaymentSchedule         paymentSchedule
+	PayoffQuote             payoffQuote
 	Permission              permission
 	Province                province
+	RefreshToken            refreshToken
 	Role                    role
 	RolePermission          rolePermission
 	TemplateTask            templateTask
@@ -139,7 +167,11 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                      db,
+		ContractStatusHistory:   q.ContractStatusHistory.clone(db),
+		CreditBureauReport:      q.CreditBureauReport.clone(db),
 		Customer:                q.Customer.clone(db),
+		CustomerBlacklist:       q.CustomerBlacklist.clone(db),
+		InsuranceRate:           q.InsuranceRate.clone(db),
 		Kabupaten:               q.Kabupaten.clone(db),
 		Kecamatan:               q.Kecamatan.clone(db),
 		Kelurahan:               q.Kelurahan.clone(db),
@@ -154,9 +186,12 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		MotorType:               q.MotorType.clone(db),
 		OAuthProvider:           q.OAuthProvider.clone(db),
 		Payment:                 q.Payment.clone(db),
+		PaymentAllocation:       q.PaymentAllocation.clone(db),
 		PaymentSchedule:         q.PaymentSchedule.clone(db),
+		PayoffQuote:             q.PayoffQuote.clone(db),
 		Permission:              q.Permission.clone(db),
 		Province:                q.Province.clone(db),
+		RefreshToken:            q.RefreshToken.clone(db),
 		Role:                    q.Role.clone(db),
 		RolePermission:          q.RolePermission.clone(db),
 		TemplateTask:            q.TemplateTask.clone(db),
@@ -178,7 +213,11 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                      db,
+		ContractStatusHistory:   q.ContractStatusHistory.replaceDB(db),
+		CreditBureauReport:      q.CreditBureauReport.replaceDB(db),
 		Customer:                q.Customer.replaceDB(db),
+		CustomerBlacklist:       q.CustomerBlacklist.replaceDB(db),
+		InsuranceRate:           q.InsuranceRate.replaceDB(db),
 		Kabupaten:               q.Kabupaten.replaceDB(db),
 		Kecamatan:               q.Kecamatan.replaceDB(db),
 		Kelurahan:               q.Kelurahan.replaceDB(db),
@@ -193,9 +232,12 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		MotorType:               q.MotorType.replaceDB(db),
 		OAuthProvider:           q.OAuthProvider.replaceDB(db),
 		Payment:                 q.Payment.replaceDB(db),
+		PaymentAllocation:       q.PaymentAllocation.replaceDB(db),
 		PaymentSchedule:         q.PaymentSchedule.replaceDB(db),
+		PayoffQuote:             q.PayoffQuote.replaceDB(db),
 		Permission:              q.Permission.replaceDB(db),
 		Province:                q.Province.replaceDB(db),
+		RefreshToken:            q.RefreshToken.replaceDB(db),
 		Role:                    q.Role.replaceDB(db),
 		RolePermission:          q.RolePermission.replaceDB(db),
 		TemplateTask:            q.TemplateTask.replaceDB(db),
@@ -207,7 +249,11 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 }
 
 type queryCtx struct {
+	ContractStatusHistory   IContractStatusHistoryDo
+	CreditBureauReport      ICreditBureauReportDo
 	Customer                ICustomerDo
+	CustomerBlacklist       ICustomerBlacklistDo
+	InsuranceRate           IInsuranceRateDo
 	Kabupaten               IKabupatenDo
 	Kecamatan               IKecamatanDo
 	Kelurahan               IKelurahanDo
@@ -222,9 +268,12 @@ type queryCtx struct {
 	MotorType               IMotorTypeDo
 	OAuthProvider           IOAuthProviderDo
 	Payment                 IPaymentDo
+	PaymentAllocation       IPaymentAllocationDo
 	PaymentSchedule         IPaymentScheduleDo
+	PayoffQuote             IPayoffQuoteDo
 	Permission              IPermissionDo
 	Province                IProvinceDo
+	RefreshToken            IRefreshTokenDo
 	Role                    IRoleDo
 	RolePermission          IRolePermissionDo
 	TemplateTask            ITemplateTaskDo
@@ -236,7 +285,11 @@ type queryCtx struct {
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		ContractStatusHistory:   q.ContractStatusHistory.WithContext(ctx),
+		CreditBureauReport:      q.CreditBureauReport.WithContext(ctx),
 		Customer:                q.Customer.WithContext(ctx),
+		CustomerBlacklist:       q.CustomerBlacklist.WithContext(ctx),
+		InsuranceRate:           q.InsuranceRate.WithContext(ctx),
 		Kabupaten:               q.Kabupaten.WithContext(ctx),
 		Kecamatan:               q.Kecamatan.WithContext(ctx),
 		Kelurahan:               q.Kelurahan.WithContext(ctx),
@@ -251,9 +304,12 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		MotorType:               q.MotorType.WithContext(ctx),
 		OAuthProvider:           q.OAuthProvider.WithContext(ctx),
 		Payment:                 q.Payment.WithContext(ctx),
+		PaymentAllocation:       q.PaymentAllocation.WithContext(ctx),
 		PaymentSchedule:         q.PaymentSchedule.WithContext(ctx),
+		PayoffQuote:             q.PayoffQuote.WithContext(ctx),
 		Permission:              q.Permission.WithContext(ctx),
 		Province:                q.Province.WithContext(ctx),
+		RefreshToken:            q.RefreshToken.WithContext(ctx),
 		Role:                    q.Role.WithContext(ctx),
 		RolePermission:          q.RolePermission.WithContext(ctx),
 		TemplateTask:            q.TemplateTask.WithContext(ctx),
@@ -0,0 +1,535 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newInsuranceRate(db *gorm.DB, opts ...gen.DOOption) insuranceRate {
+	_insuranceRate := insuranceRate{}
+
+	_insuranceRate.insuranceRateDo.UseDB(db, opts...)
+	_insuranceRate.insuranceRateDo.UseModel(&models.InsuranceRate{})
+
+	tableName := _insuranceRate.insuranceRateDo.TableName()
+	_insuranceRate.ALL = field.NewAsterisk(tableName)
+	_insuranceRate.RateID = field.NewInt64(tableName, "rate_id")
+	_insuranceRate.TenorBulan = field.NewInt16(tableName, "tenor_bulan")
+	_insuranceRate.RatePersen = field.NewFloat64(tableName, "rate_persen")
+	_insuranceRate.CreatedAt = field.NewTime(tableName, "created_at")
+	_insuranceRate.MotyID = field.NewInt64(tableName, "moty_id")
+	_insuranceRate.MotorType = insuranceRateHasOneMotorType{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("MotorType", "models.MotorType"),
+		Motors: struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("MotorType.Motors", "models.Motor"),
+			MotorTypeRef: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("MotorType.Motors.MotorTypeRef", "models.MotorType"),
+			},
+			MotorAssets: struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("MotorType.Motors.MotorAssets", "models.MotorAsset"),
+				Motor: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("MotorType.Motors.MotorAssets.Motor", "models.Motor"),
+				},
+			},
+		},
+	}
+
+	_insuranceRate.fillFieldMap()
+
+	return _insuranceRate
+}
+
+type insuranceRate struct {
+	insuranceRateDo
+
+	ALL        field.Asterisk
+	RateID     field.Int64
+	TenorBulan field.Int16
+	RatePersen field.Float64
+	CreatedAt  field.Time
+	MotyID     field.Int64
+	MotorType  insuranceRateHasOneMotorType
+
+	fieldMap map[string]field.Expr
+}
+
+func (i insuranceRate) Table(newTableName string) *insuranceRate {
+	i.insuranceRateDo.UseTable(newTableName)
+	return i.updateTableName(newTableName)
+}
+
+func (i insuranceRate) As(alias string) *insuranceRate {
+	i.insuranceRateDo.DO = *(i.insuranceRateDo.As(alias).(*gen.DO))
+	return i.updateTableName(alias)
+}
+
+func (i *insuranceRate) updateTableName(table string) *insuranceRate {
+	i.ALL = field.NewAsterisk(table)
+	i.RateID = field.NewInt64(table, "rate_id")
+	i.TenorBulan = field.NewInt16(table, "tenor_bulan")
+	i.RatePersen = field.NewFloat64(table, "rate_persen")
+	i.CreatedAt = field.NewTime(table, "created_at")
+	i.MotyID = field.NewInt64(table, "moty_id")
+
+	i.fillFieldMap()
+
+	return i
+}
+
+func (i *insuranceRate) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := i.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (i *insuranceRate) fillFieldMap() {
+	i.fieldMap = make(map[string]field.Expr, 6)
+	i.fieldMap["rate_id"] = i.RateID
+	i.fieldMap["tenor_bulan"] = i.TenorBulan
+	i.fieldMap["rate_persen"] = i.RatePersen
+	i.fieldMap["created_at"] = i.CreatedAt
+	i.fieldMap["moty_id"] = i.MotyID
+
+}
+
+func (i insuranceRate) clone(db *gorm.DB) insuranceRate {
+	i.insuranceRateDo.ReplaceConnPool(db.Statement.ConnPool)
+	i.MotorType.db = db.Session(&gorm.Session{Initialized: true})
+	i.MotorType.db.Statement.ConnPool = db.Statement.ConnPool
+	return i
+}
+
+func (i insuranceRate) replaceDB(db *gorm.DB) insuranceRate {
+	i.insuranceRateDo.ReplaceDB(db)
+	i.MotorType.db = db.Session(&gorm.Session{})
+	return i
+}
+
+type insuranceRateHasOneMotorType struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Motors struct {
+		field.RelationField
+		MotorTypeRef struct {
+			field.RelationField
+		}
+		MotorAssets struct {
+			field.RelationField
+			Motor struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a insuranceRateHasOneMotorType) Where(conds ...field.Expr) *insuranceRateHasOneMotorType {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a insuranceRateHasOneMotorType) WithContext(ctx context.Context) *insuranceRateHasOneMotorType {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a insuranceRateHasOneMotorType) Session(session *gorm.Session) *insuranceRateHasOneMotorType {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a insuranceRateHasOneMotorType) Model(m *models.InsuranceRate) *insuranceRateHasOneMotorTypeTx {
+	return &insuranceRateHasOneMotorTypeTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a insuranceRateHasOneMotorType) Unscoped() *insuranceRateHasOneMotorType {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type insuranceRateHasOneMotorTypeTx struct{ tx *gorm.Association }
+
+func (a insuranceRateHasOneMotorTypeTx) Find() (result *models.MotorType, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Append(values ...*models.MotorType) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Replace(values ...*models.MotorType) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Delete(values ...*models.MotorType) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a insuranceRateHasOneMotorTypeTx) Unscoped() *insuranceRateHasOneMotorTypeTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type insuranceRateDo struct{ gen.DO }
+
+type IInsuranceRateDo interface {
+	gen.SubQuery
+	Debug() IInsuranceRateDo
+	WithContext(ctx context.Context) IInsuranceRateDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IInsuranceRateDo
+	WriteDB() IInsuranceRateDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IInsuranceRateDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IInsuranceRateDo
+	Not(conds ...gen.Condition) IInsuranceRateDo
+	Or(conds ...gen.Condition) IInsuranceRateDo
+	Select(conds ...field.Expr) IInsuranceRateDo
+	Where(conds ...gen.Condition) IInsuranceRateDo
+	Order(conds ...field.Expr) IInsuranceRateDo
+	Distinct(cols ...field.Expr) IInsuranceRateDo
+	Omit(cols ...field.Expr) IInsuranceRateDo
+	Join(table schema.Tabler, on ...field.Expr) IInsuranceRateDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IInsuranceRateDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IInsuranceRateDo
+	Group(cols ...field.Expr) IInsuranceRateDo
+	Having(conds ...gen.Condition) IInsuranceRateDo
+	Limit(limit int) IInsuranceRateDo
+	Offset(offset int) IInsuranceRateDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IInsuranceRateDo
+	Unscoped() IInsuranceRateDo
+	Create(values ...*models.InsuranceRate) error
+	CreateInBatches(values []*models.InsuranceRate, batchSize int) error
+	Save(values ...*models.InsuranceRate) error
+	First() (*models.InsuranceRate, error)
+	Take() (*models.InsuranceRate, error)
+	Last() (*models.InsuranceRate, error)
+	Find() ([]*models.InsuranceRate, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.InsuranceRate, err error)
+	FindInBatches(result *[]*models.InsuranceRate, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.InsuranceRate) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IInsuranceRateDo
+	Assign(attrs ...field.AssignExpr) IInsuranceRateDo
+	Joins(fields ...field.RelationField) IInsuranceRateDo
+	Preload(fields ...field.RelationField) IInsuranceRateDo
+	FirstOrInit() (*models.InsuranceRate, error)
+	FirstOrCreate() (*models.InsuranceRate, error)
+	FindByPage(offset int, limit int) (result []*models.InsuranceRate, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IInsuranceRateDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (i insuranceRateDo) Debug() IInsuranceRateDo {
+	return i.withDO(i.DO.Debug())
+}
+
+func (i insuranceRateDo) WithContext(ctx context.Context) IInsuranceRateDo {
+	return i.withDO(i.DO.WithContext(ctx))
+}
+
+func (i insuranceRateDo) ReadDB() IInsuranceRateDo {
+	return i.Clauses(dbresolver.Read)
+}
+
+func (i insuranceRateDo) WriteDB() IInsuranceRateDo {
+	return i.Clauses(dbresolver.Write)
+}
+
+func (i insuranceRateDo) Session(config *gorm.Session) IInsuranceRateDo {
+	return i.withDO(i.DO.Session(config))
+}
+
+func (i insuranceRateDo) Clauses(conds ...clause.Expression) IInsuranceRateDo {
+	return i.withDO(i.DO.Clauses(conds...))
+}
+
+func (i insuranceRateDo) Returning(value interface{}, columns ...string) IInsuranceRateDo {
+	return i.withDO(i.DO.Returning(value, columns...))
+}
+
+func (i insuranceRateDo) Not(conds ...gen.Condition) IInsuranceRateDo {
+	return i.withDO(i.DO.Not(conds...))
+}
+
+func (i insuranceRateDo) Or(conds ...gen.Condition) IInsuranceRateDo {
+	return i.withDO(i.DO.Or(conds...))
+}
+
+func (i insuranceRateDo) Select(conds ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Select(conds...))
+}
+
+func (i insuranceRateDo) Where(conds ...gen.Condition) IInsuranceRateDo {
+	return i.withDO(i.DO.Where(conds...))
+}
+
+func (i insuranceRateDo) Order(conds ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Order(conds...))
+}
+
+func (i insuranceRateDo) Distinct(cols ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Distinct(cols...))
+}
+
+func (i insuranceRateDo) Omit(cols ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Omit(cols...))
+}
+
+func (i insuranceRateDo) Join(table schema.Tabler, on ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Join(table, on...))
+}
+
+func (i insuranceRateDo) LeftJoin(table schema.Tabler, on ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.LeftJoin(table, on...))
+}
+
+func (i insuranceRateDo) RightJoin(table schema.Tabler, on ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.RightJoin(table, on...))
+}
+
+func (i insuranceRateDo) Group(cols ...field.Expr) IInsuranceRateDo {
+	return i.withDO(i.DO.Group(cols...))
+}
+
+func (i insuranceRateDo) Having(conds ...gen.Condition) IInsuranceRateDo {
+	return i.withDO(i.DO.Having(conds...))
+}
+
+func (i insuranceRateDo) Limit(limit int) IInsuranceRateDo {
+	return i.withDO(i.DO.Limit(limit))
+}
+
+func (i insuranceRateDo) Offset(offset int) IInsuranceRateDo {
+	return i.withDO(i.DO.Offset(offset))
+}
+
+func (i insuranceRateDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IInsuranceRateDo {
+	return i.withDO(i.DO.Scopes(funcs...))
+}
+
+func (i insuranceRateDo) Unscoped() IInsuranceRateDo {
+	return i.withDO(i.DO.Unscoped())
+}
+
+func (i insuranceRateDo) Create(values ...*models.InsuranceRate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return i.DO.Create(values)
+}
+
+func (i insuranceRateDo) CreateInBatches(values []*models.InsuranceRate, batchSize int) error {
+	return i.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (i insuranceRateDo) Save(values ...*models.InsuranceRate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return i.DO.Save(values)
+}
+
+func (i insuranceRateDo) First() (*models.InsuranceRate, error) {
+	if result, err := i.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.InsuranceRate), nil
+	}
+}
+
+func (i insuranceRateDo) Take() (*models.InsuranceRate, error) {
+	if result, err := i.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.InsuranceRate), nil
+	}
+}
+
+func (i insuranceRateDo) Last() (*models.InsuranceRate, error) {
+	if result, err := i.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.InsuranceRate), nil
+	}
+}
+
+func (i insuranceRateDo) Find() ([]*models.InsuranceRate, error) {
+	result, err := i.DO.Find()
+	return result.([]*models.InsuranceRate), err
+}
+
+func (i insuranceRateDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.InsuranceRate, err error) {
+	buf := make([]*models.InsuranceRate, 0, batchSize)
+	err = i.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (i insuranceRateDo) FindInBatches(result *[]*models.InsuranceRate, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return i.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (i insuranceRateDo) Attrs(attrs ...field.AssignExpr) IInsuranceRateDo {
+	return i.withDO(i.DO.Attrs(attrs...))
+}
+
+func (i insuranceRateDo) Assign(attrs ...field.AssignExpr) IInsuranceRateDo {
+	return i.withDO(i.DO.Assign(attrs...))
+}
+
+func (i insuranceRateDo) Joins(fields ...field.RelationField) IInsuranceRateDo {
+	for _, _f := range fields {
+		i = *i.withDO(i.DO.Joins(_f))
+	}
+	return &i
+}
+
+func (i insuranceRateDo) Preload(fields ...field.RelationField) IInsuranceRateDo {
+	for _, _f := range fields {
+		i = *i.withDO(i.DO.Preload(_f))
+	}
+	return &i
+}
+
+func (i insuranceRateDo) FirstOrInit() (*models.InsuranceRate, error) {
+	if result, err := i.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.InsuranceRate), nil
+	}
+}
+
+func (i insuranceRateDo) FirstOrCreate() (*models.InsuranceRate, error) {
+	if result, err := i.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.InsuranceRate), nil
+	}
+}
+
+func (i insuranceRateDo) FindByPage(offset int, limit int) (result []*models.InsuranceRate, count int64, err error) {
+	result, err = i.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = i.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (i insuranceRateDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = i.Count()
+	if err != nil {
+		return
+	}
+
+	err = i.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (i insuranceRateDo) Scan(result interface{}) (err error) {
+	return i.DO.Scan(result)
+}
+
+func (i insuranceRateDo) Delete(models ...*models.InsuranceRate) (result gen.ResultInfo, err error) {
+	return i.DO.Delete(models)
+}
+
+func (i *insuranceRateDo) withDO(do gen.Dao) *insuranceRateDo {
+	i.DO = *do.(*gen.DO)
+	return i
+}
@@ -34,12 +34,23 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 	_leasingContract.TanggalAkad = field.NewTime(tableName, "tanggal_akad")
 	_leasingContract.TanggalMulaiCicil = field.NewTime(tableName, "tanggal_mulai_cicil")
 	_leasingContract.TenorBulan = field.NewInt16(tableName, "tenor_bulan")
-	_leasingContract.NilaiKendaraan = field.NewFloat64(tableName, "nilai_kendaraan")
-	_leasingContract.DPDibayar = field.NewFloat64(tableName, "dp_dibayar")
-	_leasingContract.PokokPinjaman = field.NewFloat64(tableName, "pokok_pinjaman")
-	_leasingContract.TotalPinjaman = field.NewFloat64(tableName, "total_pinjaman")
-	_leasingContract.CicilanPerBulan = field.NewFloat64(tableName, "cicilan_per_bulan")
+	_leasingContract.NilaiKendaraan = field.NewField(tableName, "nilai_kendaraan")
+	_leasingContract.DPDibayar = field.NewField(tableName, "dp_dibayar")
+	_leasingContract.PokokPinjaman = field.NewField(tableName, "pokok_pinjaman")
+	_leasingContract.TotalPinjaman = field.NewField(tableName, "total_pinjaman")
+	_leasingContract.CicilanPerBulan = field.NewField(tableName, "cicilan_per_bulan")
+	_leasingContract.MetodeBunga = field.NewString(tableName, "metode_bunga")
+	_leasingContract.BungaFlat = field.NewFloat64(tableName, "bunga_flat")
+	_leasingContract.BungaEfektif = field.NewFloat64(tableName, "bunga_efektif")
+	_leasingContract.SaldoKredit = field.NewField(tableName, "saldo_kredit")
+	_leasingContract.AdminFee = field.NewField(tableName, "admin_fee")
+	_leasingContract.AsuransiPremi = field.NewField(tableName, "asuransi_premi")
+	_leasingContract.BiayaFidusia = field.NewField(tableName, "biaya_fidusia")
+	_leasingContract.BiayaDibiayai = field.NewField(tableName, "biaya_dibiayai")
+	_leasingContract.BiayaDimuka = field.NewField(tableName, "biaya_dimuka")
 	_leasingContract.Status = field.NewString(tableName, "status")
+	_leasingContract.ManualReview = field.NewBool(tableName, "manual_review")
+	_leasingContract.ReviewReasons = field.NewString(tableName, "review_reasons")
 	_leasingContract.CreatedAt = field.NewTime(tableName, "created_at")
 	_leasingContract.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_leasingContract.CustomerID = field.NewInt64(tableName, "customer_id")
@@ -256,6 +267,15 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}
 			}
 			Payments struct {
@@ -267,6 +287,12 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					field.RelationField
 				}
 			}
+			StatusHistory struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
 		}{
 			RelationField: field.NewRelation("Customer.LeasingContracts", "models.LeasingContract"),
 			Customer: struct {
@@ -575,6 +601,15 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}
 			}{
 				RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules", "models.PaymentSchedule"),
@@ -591,6 +626,15 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}{
 					RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments", "models.Payment"),
 					Contract: struct {
@@ -603,6 +647,27 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					}{
 						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 					},
+					Allocations: struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+						Payment: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+						},
+						Schedule: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Customer.LeasingContracts.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+						},
+					},
 				},
 			},
 			Payments: struct {
@@ -623,6 +688,19 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 					RelationField: field.NewRelation("Customer.LeasingContracts.ContractDocuments.Contract", "models.LeasingContract"),
 				},
 			},
+			StatusHistory: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory", "models.ContractStatusHistory"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Customer.LeasingContracts.StatusHistory.Contract", "models.LeasingContract"),
+				},
+			},
 		},
 	}
 
@@ -662,6 +740,12 @@ func newLeasingContract(db *gorm.DB, opts ...gen.DOOption) leasingContract {
 		RelationField: field.NewRelation("ContractDocuments", "models.LeasingContractDocument"),
 	}
 
+	_leasingContract.StatusHistory = leasingContractHasManyStatusHistory{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("StatusHistory", "models.ContractStatusHistory"),
+	}
+
 	_leasingContract.fillFieldMap()
 
 	return _leasingContract
@@ -677,12 +761,23 @@ type leasingContract struct {
 	TanggalAkad       field.Time
 	TanggalMulaiCicil field.Time
 	TenorBulan        field.Int16
-	NilaiKendaraan    field.Float64
-	DPDibayar         field.Float64
-	PokokPinjaman     field.Float64
-	TotalPinjaman     field.Float64
-	CicilanPerBulan   field.Float64
+	NilaiKendaraan    field.Field
+	DPDibayar         field.Field
+	PokokPinjaman     field.Field
+	TotalPinjaman     field.Field
+	CicilanPerBulan   field.Field
+	MetodeBunga       field.String
+	BungaFlat         field.Float64
+	BungaEfektif      field.Float64
+	SaldoKredit       field.Field
+	AdminFee          field.Field
+	AsuransiPremi     field.Field
+	BiayaFidusia      field.Field
+	BiayaDibiayai     field.Field
+	BiayaDimuka       field.Field
 	Status            field.String
+	ManualReview      field.Bool
+	ReviewReasons     field.String
 	CreatedAt         field.Time
 	UpdatedAt         field.Time
 	CustomerID        field.Int64
@@ -702,6 +797,8 @@ type leasingContract struct {
 
 	ContractDocuments leasingContractHasManyContractDocuments
 
+	StatusHistory leasingContractHasManyStatusHistory
+
 	fieldMap map[string]field.Expr
 }
 
@@ -723,12 +820,23 @@ func (l *leasingContract) updateTableName(table string) *leasingContract {
 	l.TanggalAkad = field.NewTime(table, "tanggal_akad")
 	l.TanggalMulaiCicil = field.NewTime(table, "tanggal_mulai_cicil")
 	l.TenorBulan = field.NewInt16(table, "tenor_bulan")
-	l.NilaiKendaraan = field.NewFloat64(table, "nilai_kendaraan")
-	l.DPDibayar = field.NewFloat64(table, "dp_dibayar")
-	l.PokokPinjaman = field.NewFloat64(table, "pokok_pinjaman")
-	l.TotalPinjaman = field.NewFloat64(table, "total_pinjaman")
-	l.CicilanPerBulan = field.NewFloat64(table, "cicilan_per_bulan")
+	l.NilaiKendaraan = field.NewField(table, "nilai_kendaraan")
+	l.DPDibayar = field.NewField(table, "dp_dibayar")
+	l.PokokPinjaman = field.NewField(table, "pokok_pinjaman")
+	l.TotalPinjaman = field.NewField(table, "total_pinjaman")
+	l.CicilanPerBulan = field.NewField(table, "cicilan_per_bulan")
+	l.MetodeBunga = field.NewString(table, "metode_bunga")
+	l.BungaFlat = field.NewFloat64(table, "bunga_flat")
+	l.BungaEfektif = field.NewFloat64(table, "bunga_efektif")
+	l.SaldoKredit = field.NewField(table, "saldo_kredit")
+	l.AdminFee = field.NewField(table, "admin_fee")
+	l.AsuransiPremi = field.NewField(table, "asuransi_premi")
+	l.BiayaFidusia = field.NewField(table, "biaya_fidusia")
+	l.BiayaDibiayai = field.NewField(table, "biaya_dibiayai")
+	l.BiayaDimuka = field.NewField(table, "biaya_dimuka")
 	l.Status = field.NewString(table, "status")
+	l.ManualReview = field.NewBool(table, "manual_review")
+	l.ReviewReasons = field.NewString(table, "review_reasons")
 	l.CreatedAt = field.NewTime(table, "created_at")
 	l.UpdatedAt = field.NewTime(table, "updated_at")
 	l.CustomerID = field.NewInt64(table, "customer_id")
@@ -750,7 +858,7 @@ func (l *leasingContract) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (l *leasingContract) fillFieldMap() {
-	l.fieldMap = make(map[string]field.Expr, 24)
+	l.fieldMap = make(map[string]field.Expr, 36)
 	l.fieldMap["contract_id"] = l.ContractID
 	l.fieldMap["contract_number"] = l.ContractNumber
 	l.fieldMap["request_date"] = l.RequestDate
@@ -762,7 +870,18 @@ func (l *leasingContract) fillFieldMap() {
 	l.fieldMap["pokok_pinjaman"] = l.PokokPinjaman
 	l.fieldMap["total_pinjaman"] = l.TotalPinjaman
 	l.fieldMap["cicilan_per_bulan"] = l.CicilanPerBulan
+	l.fieldMap["metode_bunga"] = l.MetodeBunga
+	l.fieldMap["bunga_flat"] = l.BungaFlat
+	l.fieldMap["bunga_efektif"] = l.BungaEfektif
+	l.fieldMap["saldo_kredit"] = l.SaldoKredit
+	l.fieldMap["admin_fee"] = l.AdminFee
+	l.fieldMap["asuransi_premi"] = l.AsuransiPremi
+	l.fieldMap["biaya_fidusia"] = l.BiayaFidusia
+	l.fieldMap["biaya_dibiayai"] = l.BiayaDibiayai
+	l.fieldMap["biaya_dimuka"] = l.BiayaDimuka
 	l.fieldMap["status"] = l.Status
+	l.fieldMap["manual_review"] = l.ManualReview
+	l.fieldMap["review_reasons"] = l.ReviewReasons
 	l.fieldMap["created_at"] = l.CreatedAt
 	l.fieldMap["updated_at"] = l.UpdatedAt
 	l.fieldMap["customer_id"] = l.CustomerID
@@ -787,6 +906,8 @@ func (l leasingContract) clone(db *gorm.DB) leasingContract {
 	l.Payments.db.Statement.ConnPool = db.Statement.ConnPool
 	l.ContractDocuments.db = db.Session(&gorm.Session{Initialized: true})
 	l.ContractDocuments.db.Statement.ConnPool = db.Statement.ConnPool
+	l.StatusHistory.db = db.Session(&gorm.Session{Initialized: true})
+	l.StatusHistory.db.Statement.ConnPool = db.Statement.ConnPool
 	return l
 }
 
@@ -799,6 +920,7 @@ func (l leasingContract) replaceDB(db *gorm.DB) leasingContract {
 	l.PaymentSchedules.db = db.Session(&gorm.Session{})
 	l.Payments.db = db.Session(&gorm.Session{})
 	l.ContractDocuments.db = db.Session(&gorm.Session{})
+	l.StatusHistory.db = db.Session(&gorm.Session{})
 	return l
 }
 
@@ -924,6 +1046,15 @@ type leasingContractHasOneCustomer struct {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}
 		Payments struct {
@@ -935,6 +1066,12 @@ type leasingContractHasOneCustomer struct {
 				field.RelationField
 			}
 		}
+		StatusHistory struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
 	}
 }
 
@@ -1499,6 +1636,87 @@ func (a leasingContractHasManyContractDocumentsTx) Unscoped() *leasingContractHa
 	return &a
 }
 
+type leasingContractHasManyStatusHistory struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a leasingContractHasManyStatusHistory) Where(conds ...field.Expr) *leasingContractHasManyStatusHistory {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a leasingContractHasManyStatusHistory) WithContext(ctx context.Context) *leasingContractHasManyStatusHistory {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a leasingContractHasManyStatusHistory) Session(session *gorm.Session) *leasingContractHasManyStatusHistory {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a leasingContractHasManyStatusHistory) Model(m *models.LeasingContract) *leasingContractHasManyStatusHistoryTx {
+	return &leasingContractHasManyStatusHistoryTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a leasingContractHasManyStatusHistory) Unscoped() *leasingContractHasManyStatusHistory {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type leasingContractHasManyStatusHistoryTx struct{ tx *gorm.Association }
+
+func (a leasingContractHasManyStatusHistoryTx) Find() (result []*models.ContractStatusHistory, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Append(values ...*models.ContractStatusHistory) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Replace(values ...*models.ContractStatusHistory) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Delete(values ...*models.ContractStatusHistory) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a leasingContractHasManyStatusHistoryTx) Unscoped() *leasingContractHasManyStatusHistoryTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
 type leasingContractDo struct{ gen.DO }
 
 type ILeasingContractDo interface {
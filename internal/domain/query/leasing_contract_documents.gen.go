@@ -33,6 +33,9 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 	_leasingContractDocument.FileSize = field.NewFloat64(tableName, "file_size")
 	_leasingContractDocument.FileType = field.NewString(tableName, "file_type")
 	_leasingContractDocument.FileURL = field.NewString(tableName, "file_url")
+	_leasingContractDocument.DocType = field.NewString(tableName, "doc_type")
+	_leasingContractDocument.StoragePath = field.NewString(tableName, "storage_path")
+	_leasingContractDocument.Checksum = field.NewString(tableName, "checksum")
 	_leasingContractDocument.ContractID = field.NewInt64(tableName, "contract_id")
 	_leasingContractDocument.Contract = leasingContractDocumentHasOneContract{
 		db: db.Session(&gorm.Session{}),
@@ -496,6 +499,15 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
@@ -512,6 +524,15 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -524,6 +545,27 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 				}{
 					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -544,6 +586,19 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_leasingContractDocument.fillFieldMap()
@@ -554,14 +609,17 @@ func newLeasingContractDocument(db *gorm.DB, opts ...gen.DOOption) leasingContra
 type leasingContractDocument struct {
 	leasingContractDocumentDo
 
-	ALL        field.Asterisk
-	LocID      field.Int64
-	FileName   field.String
-	FileSize   field.Float64
-	FileType   field.String
-	FileURL    field.String
-	ContractID field.Int64
-	Contract   leasingContractDocumentHasOneContract
+	ALL         field.Asterisk
+	LocID       field.Int64
+	FileName    field.String
+	FileSize    field.Float64
+	FileType    field.String
+	FileURL     field.String
+	DocType     field.String
+	StoragePath field.String
+	Checksum    field.String
+	ContractID  field.Int64
+	Contract    leasingContractDocumentHasOneContract
 
 	fieldMap map[string]field.Expr
 }
@@ -583,6 +641,9 @@ func (l *leasingContractDocument) updateTableName(table string) *leasingContract
 	l.FileSize = field.NewFloat64(table, "file_size")
 	l.FileType = field.NewString(table, "file_type")
 	l.FileURL = field.NewString(table, "file_url")
+	l.DocType = field.NewString(table, "doc_type")
+	l.StoragePath = field.NewString(table, "storage_path")
+	l.Checksum = field.NewString(table, "checksum")
 	l.ContractID = field.NewInt64(table, "contract_id")
 
 	l.fillFieldMap()
@@ -600,12 +661,15 @@ func (l *leasingContractDocument) GetFieldByName(fieldName string) (field.OrderE
 }
 
 func (l *leasingContractDocument) fillFieldMap() {
-	l.fieldMap = make(map[string]field.Expr, 7)
+	l.fieldMap = make(map[string]field.Expr, 10)
 	l.fieldMap["loc_id"] = l.LocID
 	l.fieldMap["file_name"] = l.FileName
 	l.fieldMap["file_size"] = l.FileSize
 	l.fieldMap["file_type"] = l.FileType
 	l.fieldMap["file_url"] = l.FileURL
+	l.fieldMap["doc_type"] = l.DocType
+	l.fieldMap["storage_path"] = l.StoragePath
+	l.fieldMap["checksum"] = l.Checksum
 	l.fieldMap["contract_id"] = l.ContractID
 
 }
@@ -746,6 +810,15 @@ type leasingContractDocumentHasOneContract struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -757,6 +830,12 @@ type leasingContractDocumentHasOneContract struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a leasingContractDocumentHasOneContract) Where(conds ...field.Expr) *leasingContractDocumentHasOneContract {
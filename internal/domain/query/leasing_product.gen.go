@@ -35,8 +35,18 @@ func newLeasingProduct(db *gorm.DB, opts ...gen.DOOption) leasingProduct {
 	_leasingProduct.DPPersenMin = field.NewFloat64(tableName, "dp_persen_min")
 	_leasingProduct.DPPersenMax = field.NewFloat64(tableName, "dp_persen_max")
 	_leasingProduct.BungaFlat = field.NewFloat64(tableName, "bunga_flat")
-	_leasingProduct.AdminFee = field.NewFloat64(tableName, "admin_fee")
+	_leasingProduct.MetodeBunga = field.NewString(tableName, "metode_bunga")
+	_leasingProduct.BungaEfektif = field.NewFloat64(tableName, "bunga_efektif")
+	_leasingProduct.AdminFee = field.NewField(tableName, "admin_fee")
+	_leasingProduct.AdminFeeDibiayai = field.NewBool(tableName, "admin_fee_dibiayai")
 	_leasingProduct.Asuransi = field.NewBool(tableName, "asuransi")
+	_leasingProduct.AsuransiDibiayai = field.NewBool(tableName, "asuransi_dibiayai")
+	_leasingProduct.BiayaFidusia = field.NewField(tableName, "biaya_fidusia")
+	_leasingProduct.FidusiaDibiayai = field.NewBool(tableName, "fidusia_dibiayai")
+	_leasingProduct.DendaTipe = field.NewString(tableName, "denda_tipe")
+	_leasingProduct.DendaNilai = field.NewFloat64(tableName, "denda_nilai")
+	_leasingProduct.DendaGraceHari = field.NewInt16(tableName, "denda_grace_hari")
+	_leasingProduct.DendaMaks = field.NewField(tableName, "denda_maks")
 	_leasingProduct.CreatedAt = field.NewTime(tableName, "created_at")
 	_leasingProduct.LeasingContracts = leasingProductHasManyLeasingContracts{
 		db: db.Session(&gorm.Session{}),
@@ -500,6 +510,15 @@ func newLeasingProduct(db *gorm.DB, opts ...gen.DOOption) leasingProduct {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("LeasingContracts.PaymentSchedules", "models.PaymentSchedule"),
@@ -516,6 +535,15 @@ func newLeasingProduct(db *gorm.DB, opts ...gen.DOOption) leasingProduct {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -528,6 +556,27 @@ func newLeasingProduct(db *gorm.DB, opts ...gen.DOOption) leasingProduct {
 				}{
 					RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("LeasingContracts.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -548,6 +597,19 @@ func newLeasingProduct(db *gorm.DB, opts ...gen.DOOption) leasingProduct {
 				RelationField: field.NewRelation("LeasingContracts.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("LeasingContracts.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("LeasingContracts.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_leasingProduct.fillFieldMap()
@@ -566,8 +628,18 @@ type leasingProduct struct {
 	DPPersenMin      field.Float64
 	DPPersenMax      field.Float64
 	BungaFlat        field.Float64
-	AdminFee         field.Float64
+	MetodeBunga      field.String
+	BungaEfektif     field.Float64
+	AdminFee         field.Field
+	AdminFeeDibiayai field.Bool
 	Asuransi         field.Bool
+	AsuransiDibiayai field.Bool
+	BiayaFidusia     field.Field
+	FidusiaDibiayai  field.Bool
+	DendaTipe        field.String
+	DendaNilai       field.Float64
+	DendaGraceHari   field.Int16
+	DendaMaks        field.Field
 	CreatedAt        field.Time
 	LeasingContracts leasingProductHasManyLeasingContracts
 
@@ -593,8 +665,18 @@ func (l *leasingProduct) updateTableName(table string) *leasingProduct {
 	l.DPPersenMin = field.NewFloat64(table, "dp_persen_min")
 	l.DPPersenMax = field.NewFloat64(table, "dp_persen_max")
 	l.BungaFlat = field.NewFloat64(table, "bunga_flat")
-	l.AdminFee = field.NewFloat64(table, "admin_fee")
+	l.MetodeBunga = field.NewString(table, "metode_bunga")
+	l.BungaEfektif = field.NewFloat64(table, "bunga_efektif")
+	l.AdminFee = field.NewField(table, "admin_fee")
+	l.AdminFeeDibiayai = field.NewBool(table, "admin_fee_dibiayai")
 	l.Asuransi = field.NewBool(table, "asuransi")
+	l.AsuransiDibiayai = field.NewBool(table, "asuransi_dibiayai")
+	l.BiayaFidusia = field.NewField(table, "biaya_fidusia")
+	l.FidusiaDibiayai = field.NewBool(table, "fidusia_dibiayai")
+	l.DendaTipe = field.NewString(table, "denda_tipe")
+	l.DendaNilai = field.NewFloat64(table, "denda_nilai")
+	l.DendaGraceHari = field.NewInt16(table, "denda_grace_hari")
+	l.DendaMaks = field.NewField(table, "denda_maks")
 	l.CreatedAt = field.NewTime(table, "created_at")
 
 	l.fillFieldMap()
@@ -612,7 +694,7 @@ func (l *leasingProduct) GetFieldByName(fieldName string) (field.OrderExpr, bool
 }
 
 func (l *leasingProduct) fillFieldMap() {
-	l.fieldMap = make(map[string]field.Expr, 11)
+	l.fieldMap = make(map[string]field.Expr, 21)
 	l.fieldMap["product_id"] = l.ProductID
 	l.fieldMap["kode_produk"] = l.KodeProduk
 	l.fieldMap["nama_produk"] = l.NamaProduk
@@ -620,8 +702,18 @@ func (l *leasingProduct) fillFieldMap() {
 	l.fieldMap["dp_persen_min"] = l.DPPersenMin
 	l.fieldMap["dp_persen_max"] = l.DPPersenMax
 	l.fieldMap["bunga_flat"] = l.BungaFlat
+	l.fieldMap["metode_bunga"] = l.MetodeBunga
+	l.fieldMap["bunga_efektif"] = l.BungaEfektif
 	l.fieldMap["admin_fee"] = l.AdminFee
+	l.fieldMap["admin_fee_dibiayai"] = l.AdminFeeDibiayai
 	l.fieldMap["asuransi"] = l.Asuransi
+	l.fieldMap["asuransi_dibiayai"] = l.AsuransiDibiayai
+	l.fieldMap["biaya_fidusia"] = l.BiayaFidusia
+	l.fieldMap["fidusia_dibiayai"] = l.FidusiaDibiayai
+	l.fieldMap["denda_tipe"] = l.DendaTipe
+	l.fieldMap["denda_nilai"] = l.DendaNilai
+	l.fieldMap["denda_grace_hari"] = l.DendaGraceHari
+	l.fieldMap["denda_maks"] = l.DendaMaks
 	l.fieldMap["created_at"] = l.CreatedAt
 
 }
@@ -762,6 +854,15 @@ type leasingProductHasManyLeasingContracts struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -773,6 +874,12 @@ type leasingProductHasManyLeasingContracts struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a leasingProductHasManyLeasingContracts) Where(conds ...field.Expr) *leasingProductHasManyLeasingContracts {
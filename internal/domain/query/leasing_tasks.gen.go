@@ -30,6 +30,7 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 	_leasingTask.ALL = field.NewAsterisk(tableName)
 	_leasingTask.TaskID = field.NewInt64(tableName, "task_id")
 	_leasingTask.TaskName = field.NewString(tableName, "task_name")
+	_leasingTask.TaskCode = field.NewString(tableName, "task_code")
 	_leasingTask.StartDate = field.NewTime(tableName, "startdate")
 	_leasingTask.EndDate = field.NewTime(tableName, "enddate")
 	_leasingTask.ActualStartDate = field.NewTime(tableName, "actual_startdate")
@@ -38,6 +39,8 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 	_leasingTask.Status = field.NewString(tableName, "status")
 	_leasingTask.ContractID = field.NewInt64(tableName, "contract_id")
 	_leasingTask.RoleID = field.NewInt64(tableName, "role_id")
+	_leasingTask.CompletedBy = field.NewInt64(tableName, "completed_by")
+	_leasingTask.CompletedAt = field.NewTime(tableName, "completed_at")
 	_leasingTask.Contract = leasingTaskHasOneContract{
 		db: db.Session(&gorm.Session{}),
 
@@ -500,6 +503,15 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
@@ -516,6 +528,15 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -528,6 +549,27 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 				}{
 					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -548,6 +590,19 @@ func newLeasingTask(db *gorm.DB, opts ...gen.DOOption) leasingTask {
 				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_leasingTask.Role = leasingTaskHasOneRole{
@@ -573,6 +628,7 @@ type leasingTask struct {
 	ALL             field.Asterisk
 	TaskID          field.Int64
 	TaskName        field.String
+	TaskCode        field.String
 	StartDate       field.Time
 	EndDate         field.Time
 	ActualStartDate field.Time
@@ -581,6 +637,8 @@ type leasingTask struct {
 	Status          field.String
 	ContractID      field.Int64
 	RoleID          field.Int64
+	CompletedBy     field.Int64
+	CompletedAt     field.Time
 	Contract        leasingTaskHasOneContract
 
 	Role leasingTaskHasOneRole
@@ -604,6 +662,7 @@ func (l *leasingTask) updateTableName(table string) *leasingTask {
 	l.ALL = field.NewAsterisk(table)
 	l.TaskID = field.NewInt64(table, "task_id")
 	l.TaskName = field.NewString(table, "task_name")
+	l.TaskCode = field.NewString(table, "task_code")
 	l.StartDate = field.NewTime(table, "startdate")
 	l.EndDate = field.NewTime(table, "enddate")
 	l.ActualStartDate = field.NewTime(table, "actual_startdate")
@@ -612,6 +671,8 @@ func (l *leasingTask) updateTableName(table string) *leasingTask {
 	l.Status = field.NewString(table, "status")
 	l.ContractID = field.NewInt64(table, "contract_id")
 	l.RoleID = field.NewInt64(table, "role_id")
+	l.CompletedBy = field.NewInt64(table, "completed_by")
+	l.CompletedAt = field.NewTime(table, "completed_at")
 
 	l.fillFieldMap()
 
@@ -628,9 +689,10 @@ func (l *leasingTask) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (l *leasingTask) fillFieldMap() {
-	l.fieldMap = make(map[string]field.Expr, 13)
+	l.fieldMap = make(map[string]field.Expr, 16)
 	l.fieldMap["task_id"] = l.TaskID
 	l.fieldMap["task_name"] = l.TaskName
+	l.fieldMap["task_code"] = l.TaskCode
 	l.fieldMap["startdate"] = l.StartDate
 	l.fieldMap["enddate"] = l.EndDate
 	l.fieldMap["actual_startdate"] = l.ActualStartDate
@@ -639,6 +701,8 @@ func (l *leasingTask) fillFieldMap() {
 	l.fieldMap["status"] = l.Status
 	l.fieldMap["contract_id"] = l.ContractID
 	l.fieldMap["role_id"] = l.RoleID
+	l.fieldMap["completed_by"] = l.CompletedBy
+	l.fieldMap["completed_at"] = l.CompletedAt
 
 }
 
@@ -784,6 +848,15 @@ type leasingTaskHasOneContract struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -795,6 +868,12 @@ type leasingTaskHasOneContract struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a leasingTaskHasOneContract) Where(conds ...field.Expr) *leasingTaskHasOneContract {
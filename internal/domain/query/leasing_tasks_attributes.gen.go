@@ -109,6 +109,15 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}
 			}
 			Payments struct {
@@ -120,6 +129,12 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					field.RelationField
 				}
 			}
+			StatusHistory struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
 		}{
 			RelationField: field.NewRelation("Task.Contract", "models.LeasingContract"),
 			Customer: struct {
@@ -353,6 +368,15 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}
 			}{
 				RelationField: field.NewRelation("Task.Contract.PaymentSchedules", "models.PaymentSchedule"),
@@ -369,6 +393,15 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					Schedule struct {
 						field.RelationField
 					}
+					Allocations struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}
 				}{
 					RelationField: field.NewRelation("Task.Contract.PaymentSchedules.Payments", "models.Payment"),
 					Contract: struct {
@@ -381,6 +414,27 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					}{
 						RelationField: field.NewRelation("Task.Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 					},
+					Allocations: struct {
+						field.RelationField
+						Payment struct {
+							field.RelationField
+						}
+						Schedule struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Task.Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+						Payment: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+						},
+						Schedule: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+						},
+					},
 				},
 			},
 			Payments: struct {
@@ -401,6 +455,19 @@ func newLeasingTaskAttribute(db *gorm.DB, opts ...gen.DOOption) leasingTaskAttri
 					RelationField: field.NewRelation("Task.Contract.ContractDocuments.Contract", "models.LeasingContract"),
 				},
 			},
+			StatusHistory: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Task.Contract.StatusHistory", "models.ContractStatusHistory"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Contract.StatusHistory.Contract", "models.LeasingContract"),
+				},
+			},
 		},
 		Role: struct {
 			field.RelationField
@@ -729,6 +796,15 @@ type leasingTaskAttributeBelongsToTask struct {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}
 		Payments struct {
@@ -740,6 +816,12 @@ type leasingTaskAttributeBelongsToTask struct {
 				field.RelationField
 			}
 		}
+		StatusHistory struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
 	}
 	Role struct {
 		field.RelationField
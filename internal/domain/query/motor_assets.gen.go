@@ -33,6 +33,8 @@ func newMotorAsset(db *gorm.DB, opts ...gen.DOOption) motorAsset {
 	_motorAsset.FileSize = field.NewFloat64(tableName, "file_size")
 	_motorAsset.FileType = field.NewString(tableName, "file_type")
 	_motorAsset.FileURL = field.NewString(tableName, "file_url")
+	_motorAsset.StoragePath = field.NewString(tableName, "storage_path")
+	_motorAsset.Checksum = field.NewString(tableName, "checksum")
 	_motorAsset.MoasMotorID = field.NewInt64(tableName, "moas_motor_id")
 	_motorAsset.Motor = motorAssetBelongsToMotor{
 		db: db.Session(&gorm.Session{}),
@@ -80,6 +82,8 @@ type motorAsset struct {
 	FileSize    field.Float64
 	FileType    field.String
 	FileURL     field.String
+	StoragePath field.String
+	Checksum    field.String
 	MoasMotorID field.Int64
 	Motor       motorAssetBelongsToMotor
 
@@ -103,6 +107,8 @@ func (m *motorAsset) updateTableName(table string) *motorAsset {
 	m.FileSize = field.NewFloat64(table, "file_size")
 	m.FileType = field.NewString(table, "file_type")
 	m.FileURL = field.NewString(table, "file_url")
+	m.StoragePath = field.NewString(table, "storage_path")
+	m.Checksum = field.NewString(table, "checksum")
 	m.MoasMotorID = field.NewInt64(table, "moas_motor_id")
 
 	m.fillFieldMap()
@@ -120,12 +126,14 @@ func (m *motorAsset) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *motorAsset) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 7)
+	m.fieldMap = make(map[string]field.Expr, 9)
 	m.fieldMap["moas_id"] = m.MoasID
 	m.fieldMap["file_name"] = m.FileName
 	m.fieldMap["file_size"] = m.FileSize
 	m.fieldMap["file_type"] = m.FileType
 	m.fieldMap["file_url"] = m.FileURL
+	m.fieldMap["storage_path"] = m.StoragePath
+	m.fieldMap["checksum"] = m.Checksum
 	m.fieldMap["moas_motor_id"] = m.MoasMotorID
 
 }
@@ -38,7 +38,7 @@ func newMotor(db *gorm.DB, opts ...gen.DOOption) motor {
 	_motor.CCMesin = field.NewString(tableName, "cc_mesin")
 	_motor.NomorPolisi = field.NewString(tableName, "nomor_polisi")
 	_motor.StatusUnit = field.NewString(tableName, "status_unit")
-	_motor.HargaOTR = field.NewFloat64(tableName, "harga_otr")
+	_motor.HargaOTR = field.NewField(tableName, "harga_otr")
 	_motor.CreatedAt = field.NewTime(tableName, "created_at")
 	_motor.MotorMotyID = field.NewInt64(tableName, "motor_moty_id")
 	_motor.MotorAssets = motorHasManyMotorAssets{
@@ -104,7 +104,7 @@ type motor struct {
 	CCMesin     field.String
 	NomorPolisi field.String
 	StatusUnit  field.String
-	HargaOTR    field.Float64
+	HargaOTR    field.Field
 	CreatedAt   field.Time
 	MotorMotyID field.Int64
 	MotorAssets motorHasManyMotorAssets
@@ -136,7 +136,7 @@ func (m *motor) updateTableName(table string) *motor {
 	m.CCMesin = field.NewString(table, "cc_mesin")
 	m.NomorPolisi = field.NewString(table, "nomor_polisi")
 	m.StatusUnit = field.NewString(table, "status_unit")
-	m.HargaOTR = field.NewFloat64(table, "harga_otr")
+	m.HargaOTR = field.NewField(table, "harga_otr")
 	m.CreatedAt = field.NewTime(table, "created_at")
 	m.MotorMotyID = field.NewInt64(table, "motor_moty_id")
 
@@ -0,0 +1,1388 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newPaymentAllocation(db *gorm.DB, opts ...gen.DOOption) paymentAllocation {
+	_paymentAllocation := paymentAllocation{}
+
+	_paymentAllocation.paymentAllocationDo.UseDB(db, opts...)
+	_paymentAllocation.paymentAllocationDo.UseModel(&models.PaymentAllocation{})
+
+	tableName := _paymentAllocation.paymentAllocationDo.TableName()
+	_paymentAllocation.ALL = field.NewAsterisk(tableName)
+	_paymentAllocation.AllocationID = field.NewInt64(tableName, "allocation_id")
+	_paymentAllocation.Kind = field.NewString(tableName, "kind")
+	_paymentAllocation.Amount = field.NewField(tableName, "amount")
+	_paymentAllocation.CreatedAt = field.NewTime(tableName, "created_at")
+	_paymentAllocation.PaymentID = field.NewInt64(tableName, "payment_id")
+	_paymentAllocation.ScheduleID = field.NewInt64(tableName, "schedule_id")
+	_paymentAllocation.Payment = paymentAllocationHasOnePayment{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Payment", "models.Payment"),
+		Contract: struct {
+			field.RelationField
+			Customer struct {
+				field.RelationField
+				Location struct {
+					field.RelationField
+					Kelurahan struct {
+						field.RelationField
+						Kecamatan struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}
+							Kelurahan struct {
+								field.RelationField
+							}
+						}
+						Locations struct {
+							field.RelationField
+						}
+					}
+				}
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}
+			Motor struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}
+			Product struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}
+			LeasingTasks struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}
+			PaymentSchedules struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+				}
+			}
+			Payments struct {
+				field.RelationField
+			}
+			ContractDocuments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+			StatusHistory struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Payment.Contract", "models.LeasingContract"),
+			Customer: struct {
+				field.RelationField
+				Location struct {
+					field.RelationField
+					Kelurahan struct {
+						field.RelationField
+						Kecamatan struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}
+							Kelurahan struct {
+								field.RelationField
+							}
+						}
+						Locations struct {
+							field.RelationField
+						}
+					}
+				}
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.Customer", "models.Customer"),
+				Location: struct {
+					field.RelationField
+					Kelurahan struct {
+						field.RelationField
+						Kecamatan struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}
+							Kelurahan struct {
+								field.RelationField
+							}
+						}
+						Locations struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Payment.Contract.Customer.Location", "models.Location"),
+					Kelurahan: struct {
+						field.RelationField
+						Kecamatan struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}
+							Kelurahan struct {
+								field.RelationField
+							}
+						}
+						Locations struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan", "models.Kelurahan"),
+						Kecamatan: struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}
+							Kelurahan struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan", "models.Kecamatan"),
+							Kabupaten: struct {
+								field.RelationField
+								Province struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}
+								Kecamatan struct {
+									field.RelationField
+								}
+							}{
+								RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten", "models.Kabupaten"),
+								Province: struct {
+									field.RelationField
+									Kabupaten struct {
+										field.RelationField
+									}
+								}{
+									RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province", "models.Province"),
+									Kabupaten: struct {
+										field.RelationField
+									}{
+										RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province.Kabupaten", "models.Kabupaten"),
+									},
+								},
+								Kecamatan: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Kecamatan", "models.Kecamatan"),
+								},
+							},
+							Kelurahan: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Kecamatan.Kelurahan", "models.Kelurahan"),
+							},
+						},
+						Locations: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Payment.Contract.Customer.Location.Kelurahan.Locations", "models.Location"),
+						},
+					},
+				},
+				LeasingContracts: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.Customer.LeasingContracts", "models.LeasingContract"),
+				},
+			},
+			Motor: struct {
+				field.RelationField
+				MotorTypeRef struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}
+				MotorAssets struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.Motor", "models.Motor"),
+				MotorTypeRef: struct {
+					field.RelationField
+					Motors struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Payment.Contract.Motor.MotorTypeRef", "models.MotorType"),
+					Motors: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Payment.Contract.Motor.MotorTypeRef.Motors", "models.Motor"),
+					},
+				},
+				MotorAssets: struct {
+					field.RelationField
+					Motor struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Payment.Contract.Motor.MotorAssets", "models.MotorAsset"),
+					Motor: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Payment.Contract.Motor.MotorAssets.Motor", "models.Motor"),
+					},
+				},
+			},
+			Product: struct {
+				field.RelationField
+				LeasingContracts struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.Product", "models.LeasingProduct"),
+				LeasingContracts: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.Product.LeasingContracts", "models.LeasingContract"),
+				},
+			},
+			LeasingTasks: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Role struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				LeasingAttribute struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.LeasingTasks", "models.LeasingTask"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Contract", "models.LeasingContract"),
+				},
+				Role: struct {
+					field.RelationField
+					UserRoles struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}
+					RolePermissions struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role", "models.Role"),
+					UserRoles: struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}
+						Role struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles", "models.UserRole"),
+						User: struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}
+							UserRoles struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User", "models.User"),
+							UserOAuthProviders: struct {
+								field.RelationField
+								User struct {
+									field.RelationField
+								}
+								Provider struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}
+							}{
+								RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders", "models.UserOAuthProvider"),
+								User: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.User", "models.User"),
+								},
+								Provider: struct {
+									field.RelationField
+									UserOAuthProviders struct {
+										field.RelationField
+									}
+								}{
+									RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+									UserOAuthProviders: struct {
+										field.RelationField
+									}{
+										RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+									},
+								},
+							},
+							UserRoles: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.User.UserRoles", "models.UserRole"),
+							},
+						},
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.UserRoles.Role", "models.Role"),
+						},
+					},
+					RolePermissions: struct {
+						field.RelationField
+						Role struct {
+							field.RelationField
+						}
+						Permission struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}
+					}{
+						RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.RolePermissions", "models.RolePermission"),
+						Role: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.RolePermissions.Role", "models.Role"),
+						},
+						Permission: struct {
+							field.RelationField
+							RolePermissions struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.RolePermissions.Permission", "models.Permission"),
+							RolePermissions: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Payment.Contract.LeasingTasks.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+							},
+						},
+					},
+				},
+				LeasingAttribute: struct {
+					field.RelationField
+					Task struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Payment.Contract.LeasingTasks.LeasingAttribute", "models.LeasingTaskAttribute"),
+					Task: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Payment.Contract.LeasingTasks.LeasingAttribute.Task", "models.LeasingTask"),
+					},
+				},
+			},
+			PaymentSchedules: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Payments struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.PaymentSchedules", "models.PaymentSchedule"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.PaymentSchedules.Contract", "models.LeasingContract"),
+				},
+				Payments: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.PaymentSchedules.Payments", "models.Payment"),
+				},
+			},
+			Payments: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Payment.Contract.Payments", "models.Payment"),
+			},
+			ContractDocuments: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.ContractDocuments", "models.LeasingContractDocument"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.ContractDocuments.Contract", "models.LeasingContract"),
+				},
+			},
+			StatusHistory: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Payment.Contract.StatusHistory", "models.ContractStatusHistory"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Payment.Contract.StatusHistory.Contract", "models.LeasingContract"),
+				},
+			},
+		},
+		Schedule: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Payment.Schedule", "models.PaymentSchedule"),
+		},
+		Allocations: struct {
+			field.RelationField
+			Payment struct {
+				field.RelationField
+			}
+			Schedule struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Payment.Allocations", "models.PaymentAllocation"),
+			Payment: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Payment.Allocations.Payment", "models.Payment"),
+			},
+			Schedule: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Payment.Allocations.Schedule", "models.PaymentSchedule"),
+			},
+		},
+	}
+
+	_paymentAllocation.Schedule = paymentAllocationHasOneSchedule{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Schedule", "models.PaymentSchedule"),
+	}
+
+	_paymentAllocation.fillFieldMap()
+
+	return _paymentAllocation
+}
+
+type paymentAllocation struct {
+	paymentAllocationDo
+
+	ALL          field.Asterisk
+	AllocationID field.Int64
+	Kind         field.String
+	Amount       field.Field
+	CreatedAt    field.Time
+	PaymentID    field.Int64
+	ScheduleID   field.Int64
+	Payment      paymentAllocationHasOnePayment
+
+	Schedule paymentAllocationHasOneSchedule
+
+	fieldMap map[string]field.Expr
+}
+
+func (p paymentAllocation) Table(newTableName string) *paymentAllocation {
+	p.paymentAllocationDo.UseTable(newTableName)
+	return p.updateTableName(newTableName)
+}
+
+func (p paymentAllocation) As(alias string) *paymentAllocation {
+	p.paymentAllocationDo.DO = *(p.paymentAllocationDo.As(alias).(*gen.DO))
+	return p.updateTableName(alias)
+}
+
+func (p *paymentAllocation) updateTableName(table string) *paymentAllocation {
+	p.ALL = field.NewAsterisk(table)
+	p.AllocationID = field.NewInt64(table, "allocation_id")
+	p.Kind = field.NewString(table, "kind")
+	p.Amount = field.NewField(table, "amount")
+	p.CreatedAt = field.NewTime(table, "created_at")
+	p.PaymentID = field.NewInt64(table, "payment_id")
+	p.ScheduleID = field.NewInt64(table, "schedule_id")
+
+	p.fillFieldMap()
+
+	return p
+}
+
+func (p *paymentAllocation) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := p.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (p *paymentAllocation) fillFieldMap() {
+	p.fieldMap = make(map[string]field.Expr, 8)
+	p.fieldMap["allocation_id"] = p.AllocationID
+	p.fieldMap["kind"] = p.Kind
+	p.fieldMap["amount"] = p.Amount
+	p.fieldMap["created_at"] = p.CreatedAt
+	p.fieldMap["payment_id"] = p.PaymentID
+	p.fieldMap["schedule_id"] = p.ScheduleID
+
+}
+
+func (p paymentAllocation) clone(db *gorm.DB) paymentAllocation {
+	p.paymentAllocationDo.ReplaceConnPool(db.Statement.ConnPool)
+	p.Payment.db = db.Session(&gorm.Session{Initialized: true})
+	p.Payment.db.Statement.ConnPool = db.Statement.ConnPool
+	p.Schedule.db = db.Session(&gorm.Session{Initialized: true})
+	p.Schedule.db.Statement.ConnPool = db.Statement.ConnPool
+	return p
+}
+
+func (p paymentAllocation) replaceDB(db *gorm.DB) paymentAllocation {
+	p.paymentAllocationDo.ReplaceDB(db)
+	p.Payment.db = db.Session(&gorm.Session{})
+	p.Schedule.db = db.Session(&gorm.Session{})
+	return p
+}
+
+type paymentAllocationHasOnePayment struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Contract struct {
+		field.RelationField
+		Customer struct {
+			field.RelationField
+			Location struct {
+				field.RelationField
+				Kelurahan struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}
+			}
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}
+		Motor struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}
+		Product struct {
+			field.RelationField
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}
+		LeasingTasks struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			LeasingAttribute struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}
+		}
+		PaymentSchedules struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Payments struct {
+				field.RelationField
+			}
+		}
+		Payments struct {
+			field.RelationField
+		}
+		ContractDocuments struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+		StatusHistory struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}
+	}
+	Schedule struct {
+		field.RelationField
+	}
+	Allocations struct {
+		field.RelationField
+		Payment struct {
+			field.RelationField
+		}
+		Schedule struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a paymentAllocationHasOnePayment) Where(conds ...field.Expr) *paymentAllocationHasOnePayment {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a paymentAllocationHasOnePayment) WithContext(ctx context.Context) *paymentAllocationHasOnePayment {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a paymentAllocationHasOnePayment) Session(session *gorm.Session) *paymentAllocationHasOnePayment {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a paymentAllocationHasOnePayment) Model(m *models.PaymentAllocation) *paymentAllocationHasOnePaymentTx {
+	return &paymentAllocationHasOnePaymentTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a paymentAllocationHasOnePayment) Unscoped() *paymentAllocationHasOnePayment {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type paymentAllocationHasOnePaymentTx struct{ tx *gorm.Association }
+
+func (a paymentAllocationHasOnePaymentTx) Find() (result *models.Payment, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a paymentAllocationHasOnePaymentTx) Append(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a paymentAllocationHasOnePaymentTx) Replace(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a paymentAllocationHasOnePaymentTx) Delete(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a paymentAllocationHasOnePaymentTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a paymentAllocationHasOnePaymentTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a paymentAllocationHasOnePaymentTx) Unscoped() *paymentAllocationHasOnePaymentTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type paymentAllocationHasOneSchedule struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a paymentAllocationHasOneSchedule) Where(conds ...field.Expr) *paymentAllocationHasOneSchedule {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a paymentAllocationHasOneSchedule) WithContext(ctx context.Context) *paymentAllocationHasOneSchedule {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a paymentAllocationHasOneSchedule) Session(session *gorm.Session) *paymentAllocationHasOneSchedule {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a paymentAllocationHasOneSchedule) Model(m *models.PaymentAllocation) *paymentAllocationHasOneScheduleTx {
+	return &paymentAllocationHasOneScheduleTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a paymentAllocationHasOneSchedule) Unscoped() *paymentAllocationHasOneSchedule {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type paymentAllocationHasOneScheduleTx struct{ tx *gorm.Association }
+
+func (a paymentAllocationHasOneScheduleTx) Find() (result *models.PaymentSchedule, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a paymentAllocationHasOneScheduleTx) Append(values ...*models.PaymentSchedule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a paymentAllocationHasOneScheduleTx) Replace(values ...*models.PaymentSchedule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a paymentAllocationHasOneScheduleTx) Delete(values ...*models.PaymentSchedule) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a paymentAllocationHasOneScheduleTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a paymentAllocationHasOneScheduleTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a paymentAllocationHasOneScheduleTx) Unscoped() *paymentAllocationHasOneScheduleTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type paymentAllocationDo struct{ gen.DO }
+
+type IPaymentAllocationDo interface {
+	gen.SubQuery
+	Debug() IPaymentAllocationDo
+	WithContext(ctx context.Context) IPaymentAllocationDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IPaymentAllocationDo
+	WriteDB() IPaymentAllocationDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IPaymentAllocationDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IPaymentAllocationDo
+	Not(conds ...gen.Condition) IPaymentAllocationDo
+	Or(conds ...gen.Condition) IPaymentAllocationDo
+	Select(conds ...field.Expr) IPaymentAllocationDo
+	Where(conds ...gen.Condition) IPaymentAllocationDo
+	Order(conds ...field.Expr) IPaymentAllocationDo
+	Distinct(cols ...field.Expr) IPaymentAllocationDo
+	Omit(cols ...field.Expr) IPaymentAllocationDo
+	Join(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo
+	Group(cols ...field.Expr) IPaymentAllocationDo
+	Having(conds ...gen.Condition) IPaymentAllocationDo
+	Limit(limit int) IPaymentAllocationDo
+	Offset(offset int) IPaymentAllocationDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IPaymentAllocationDo
+	Unscoped() IPaymentAllocationDo
+	Create(values ...*models.PaymentAllocation) error
+	CreateInBatches(values []*models.PaymentAllocation, batchSize int) error
+	Save(values ...*models.PaymentAllocation) error
+	First() (*models.PaymentAllocation, error)
+	Take() (*models.PaymentAllocation, error)
+	Last() (*models.PaymentAllocation, error)
+	Find() ([]*models.PaymentAllocation, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.PaymentAllocation, err error)
+	FindInBatches(result *[]*models.PaymentAllocation, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.PaymentAllocation) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IPaymentAllocationDo
+	Assign(attrs ...field.AssignExpr) IPaymentAllocationDo
+	Joins(fields ...field.RelationField) IPaymentAllocationDo
+	Preload(fields ...field.RelationField) IPaymentAllocationDo
+	FirstOrInit() (*models.PaymentAllocation, error)
+	FirstOrCreate() (*models.PaymentAllocation, error)
+	FindByPage(offset int, limit int) (result []*models.PaymentAllocation, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IPaymentAllocationDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (p paymentAllocationDo) Debug() IPaymentAllocationDo {
+	return p.withDO(p.DO.Debug())
+}
+
+func (p paymentAllocationDo) WithContext(ctx context.Context) IPaymentAllocationDo {
+	return p.withDO(p.DO.WithContext(ctx))
+}
+
+func (p paymentAllocationDo) ReadDB() IPaymentAllocationDo {
+	return p.Clauses(dbresolver.Read)
+}
+
+func (p paymentAllocationDo) WriteDB() IPaymentAllocationDo {
+	return p.Clauses(dbresolver.Write)
+}
+
+func (p paymentAllocationDo) Session(config *gorm.Session) IPaymentAllocationDo {
+	return p.withDO(p.DO.Session(config))
+}
+
+func (p paymentAllocationDo) Clauses(conds ...clause.Expression) IPaymentAllocationDo {
+	return p.withDO(p.DO.Clauses(conds...))
+}
+
+func (p paymentAllocationDo) Returning(value interface{}, columns ...string) IPaymentAllocationDo {
+	return p.withDO(p.DO.Returning(value, columns...))
+}
+
+func (p paymentAllocationDo) Not(conds ...gen.Condition) IPaymentAllocationDo {
+	return p.withDO(p.DO.Not(conds...))
+}
+
+func (p paymentAllocationDo) Or(conds ...gen.Condition) IPaymentAllocationDo {
+	return p.withDO(p.DO.Or(conds...))
+}
+
+func (p paymentAllocationDo) Select(conds ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Select(conds...))
+}
+
+func (p paymentAllocationDo) Where(conds ...gen.Condition) IPaymentAllocationDo {
+	return p.withDO(p.DO.Where(conds...))
+}
+
+func (p paymentAllocationDo) Order(conds ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Order(conds...))
+}
+
+func (p paymentAllocationDo) Distinct(cols ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Distinct(cols...))
+}
+
+func (p paymentAllocationDo) Omit(cols ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Omit(cols...))
+}
+
+func (p paymentAllocationDo) Join(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Join(table, on...))
+}
+
+func (p paymentAllocationDo) LeftJoin(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.LeftJoin(table, on...))
+}
+
+func (p paymentAllocationDo) RightJoin(table schema.Tabler, on ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.RightJoin(table, on...))
+}
+
+func (p paymentAllocationDo) Group(cols ...field.Expr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Group(cols...))
+}
+
+func (p paymentAllocationDo) Having(conds ...gen.Condition) IPaymentAllocationDo {
+	return p.withDO(p.DO.Having(conds...))
+}
+
+func (p paymentAllocationDo) Limit(limit int) IPaymentAllocationDo {
+	return p.withDO(p.DO.Limit(limit))
+}
+
+func (p paymentAllocationDo) Offset(offset int) IPaymentAllocationDo {
+	return p.withDO(p.DO.Offset(offset))
+}
+
+func (p paymentAllocationDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IPaymentAllocationDo {
+	return p.withDO(p.DO.Scopes(funcs...))
+}
+
+func (p paymentAllocationDo) Unscoped() IPaymentAllocationDo {
+	return p.withDO(p.DO.Unscoped())
+}
+
+func (p paymentAllocationDo) Create(values ...*models.PaymentAllocation) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Create(values)
+}
+
+func (p paymentAllocationDo) CreateInBatches(values []*models.PaymentAllocation, batchSize int) error {
+	return p.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (p paymentAllocationDo) Save(values ...*models.PaymentAllocation) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Save(values)
+}
+
+func (p paymentAllocationDo) First() (*models.PaymentAllocation, error) {
+	if result, err := p.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PaymentAllocation), nil
+	}
+}
+
+func (p paymentAllocationDo) Take() (*models.PaymentAllocation, error) {
+	if result, err := p.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PaymentAllocation), nil
+	}
+}
+
+func (p paymentAllocationDo) Last() (*models.PaymentAllocation, error) {
+	if result, err := p.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PaymentAllocation), nil
+	}
+}
+
+func (p paymentAllocationDo) Find() ([]*models.PaymentAllocation, error) {
+	result, err := p.DO.Find()
+	return result.([]*models.PaymentAllocation), err
+}
+
+func (p paymentAllocationDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.PaymentAllocation, err error) {
+	buf := make([]*models.PaymentAllocation, 0, batchSize)
+	err = p.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (p paymentAllocationDo) FindInBatches(result *[]*models.PaymentAllocation, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return p.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (p paymentAllocationDo) Attrs(attrs ...field.AssignExpr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Attrs(attrs...))
+}
+
+func (p paymentAllocationDo) Assign(attrs ...field.AssignExpr) IPaymentAllocationDo {
+	return p.withDO(p.DO.Assign(attrs...))
+}
+
+func (p paymentAllocationDo) Joins(fields ...field.RelationField) IPaymentAllocationDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Joins(_f))
+	}
+	return &p
+}
+
+func (p paymentAllocationDo) Preload(fields ...field.RelationField) IPaymentAllocationDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Preload(_f))
+	}
+	return &p
+}
+
+func (p paymentAllocationDo) FirstOrInit() (*models.PaymentAllocation, error) {
+	if result, err := p.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PaymentAllocation), nil
+	}
+}
+
+func (p paymentAllocationDo) FirstOrCreate() (*models.PaymentAllocation, error) {
+	if result, err := p.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PaymentAllocation), nil
+	}
+}
+
+func (p paymentAllocationDo) FindByPage(offset int, limit int) (result []*models.PaymentAllocation, count int64, err error) {
+	result, err = p.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = p.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (p paymentAllocationDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = p.Count()
+	if err != nil {
+		return
+	}
+
+	err = p.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (p paymentAllocationDo) Scan(result interface{}) (err error) {
+	return p.DO.Scan(result)
+}
+
+func (p paymentAllocationDo) Delete(models ...*models.PaymentAllocation) (result gen.ResultInfo, err error) {
+	return p.DO.Delete(models)
+}
+
+func (p *paymentAllocationDo) withDO(do gen.Dao) *paymentAllocationDo {
+	p.DO = *do.(*gen.DO)
+	return p
+}
@@ -31,9 +31,12 @@ func newPaymentSchedule(db *gorm.DB, opts ...gen.DOOption) paymentSchedule {
 	_paymentSchedule.ScheduleID = field.NewInt64(tableName, "schedule_id")
 	_paymentSchedule.AngsuranKe = field.NewInt16(tableName, "angsuran_ke")
 	_paymentSchedule.JatuhTempo = field.NewTime(tableName, "jatuh_tempo")
-	_paymentSchedule.Pokok = field.NewFloat64(tableName, "pokok")
-	_paymentSchedule.Margin = field.NewFloat64(tableName, "margin")
-	_paymentSchedule.TotalTagihan = field.NewFloat64(tableName, "total_tagihan")
+	_paymentSchedule.Pokok = field.NewField(tableName, "pokok")
+	_paymentSchedule.Margin = field.NewField(tableName, "margin")
+	_paymentSchedule.TotalTagihan = field.NewField(tableName, "total_tagihan")
+	_paymentSchedule.Terbayar = field.NewField(tableName, "terbayar")
+	_paymentSchedule.Denda = field.NewField(tableName, "denda")
+	_paymentSchedule.DendaTerbayar = field.NewField(tableName, "denda_terbayar")
 	_paymentSchedule.StatusPembayaran = field.NewString(tableName, "status_pembayaran")
 	_paymentSchedule.TanggalBayar = field.NewTime(tableName, "tanggal_bayar")
 	_paymentSchedule.CreatedAt = field.NewTime(tableName, "created_at")
@@ -500,6 +503,15 @@ func newPaymentSchedule(db *gorm.DB, opts ...gen.DOOption) paymentSchedule {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
@@ -516,6 +528,15 @@ func newPaymentSchedule(db *gorm.DB, opts ...gen.DOOption) paymentSchedule {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -528,6 +549,27 @@ func newPaymentSchedule(db *gorm.DB, opts ...gen.DOOption) paymentSchedule {
 				}{
 					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -548,6 +590,19 @@ func newPaymentSchedule(db *gorm.DB, opts ...gen.DOOption) paymentSchedule {
 				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_paymentSchedule.Payments = paymentScheduleHasManyPayments{
@@ -568,9 +623,12 @@ type paymentSchedule struct {
 	ScheduleID       field.Int64
 	AngsuranKe       field.Int16
 	JatuhTempo       field.Time
-	Pokok            field.Float64
-	Margin           field.Float64
-	TotalTagihan     field.Float64
+	Pokok            field.Field
+	Margin           field.Field
+	TotalTagihan     field.Field
+	Terbayar         field.Field
+	Denda            field.Field
+	DendaTerbayar    field.Field
 	StatusPembayaran field.String
 	TanggalBayar     field.Time
 	CreatedAt        field.Time
@@ -597,9 +655,12 @@ func (p *paymentSchedule) updateTableName(table string) *paymentSchedule {
 	p.ScheduleID = field.NewInt64(table, "schedule_id")
 	p.AngsuranKe = field.NewInt16(table, "angsuran_ke")
 	p.JatuhTempo = field.NewTime(table, "jatuh_tempo")
-	p.Pokok = field.NewFloat64(table, "pokok")
-	p.Margin = field.NewFloat64(table, "margin")
-	p.TotalTagihan = field.NewFloat64(table, "total_tagihan")
+	p.Pokok = field.NewField(table, "pokok")
+	p.Margin = field.NewField(table, "margin")
+	p.TotalTagihan = field.NewField(table, "total_tagihan")
+	p.Terbayar = field.NewField(table, "terbayar")
+	p.Denda = field.NewField(table, "denda")
+	p.DendaTerbayar = field.NewField(table, "denda_terbayar")
 	p.StatusPembayaran = field.NewString(table, "status_pembayaran")
 	p.TanggalBayar = field.NewTime(table, "tanggal_bayar")
 	p.CreatedAt = field.NewTime(table, "created_at")
@@ -620,13 +681,16 @@ func (p *paymentSchedule) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (p *paymentSchedule) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 12)
+	p.fieldMap = make(map[string]field.Expr, 15)
 	p.fieldMap["schedule_id"] = p.ScheduleID
 	p.fieldMap["angsuran_ke"] = p.AngsuranKe
 	p.fieldMap["jatuh_tempo"] = p.JatuhTempo
 	p.fieldMap["pokok"] = p.Pokok
 	p.fieldMap["margin"] = p.Margin
 	p.fieldMap["total_tagihan"] = p.TotalTagihan
+	p.fieldMap["terbayar"] = p.Terbayar
+	p.fieldMap["denda"] = p.Denda
+	p.fieldMap["denda_terbayar"] = p.DendaTerbayar
 	p.fieldMap["status_pembayaran"] = p.StatusPembayaran
 	p.fieldMap["tanggal_bayar"] = p.TanggalBayar
 	p.fieldMap["created_at"] = p.CreatedAt
@@ -773,6 +837,15 @@ type paymentScheduleHasOneContract struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -784,6 +857,12 @@ type paymentScheduleHasOneContract struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a paymentScheduleHasOneContract) Where(conds ...field.Expr) *paymentScheduleHasOneContract {
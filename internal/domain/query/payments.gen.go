@@ -30,7 +30,7 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 	_payment.ALL = field.NewAsterisk(tableName)
 	_payment.PaymentID = field.NewInt64(tableName, "payment_id")
 	_payment.NomorBukti = field.NewString(tableName, "nomor_bukti")
-	_payment.JumlahBayar = field.NewFloat64(tableName, "jumlah_bayar")
+	_payment.JumlahBayar = field.NewField(tableName, "jumlah_bayar")
 	_payment.TanggalBayar = field.NewTime(tableName, "tanggal_bayar")
 	_payment.MetodePembayaran = field.NewString(tableName, "metode_pembayaran")
 	_payment.Provider = field.NewString(tableName, "provider")
@@ -499,6 +499,15 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
@@ -515,6 +524,15 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 				Schedule struct {
 					field.RelationField
 				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
 				Contract: struct {
@@ -527,6 +545,27 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 				}{
 					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
 				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
 			},
 		},
 		Payments: struct {
@@ -547,6 +586,19 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
 			},
 		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
 	}
 
 	_payment.Schedule = paymentHasOneSchedule{
@@ -555,6 +607,12 @@ func newPayment(db *gorm.DB, opts ...gen.DOOption) payment {
 		RelationField: field.NewRelation("Schedule", "models.PaymentSchedule"),
 	}
 
+	_payment.Allocations = paymentHasManyAllocations{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Allocations", "models.PaymentAllocation"),
+	}
+
 	_payment.fillFieldMap()
 
 	return _payment
@@ -566,7 +624,7 @@ type payment struct {
 	ALL              field.Asterisk
 	PaymentID        field.Int64
 	NomorBukti       field.String
-	JumlahBayar      field.Float64
+	JumlahBayar      field.Field
 	TanggalBayar     field.Time
 	MetodePembayaran field.String
 	Provider         field.String
@@ -577,6 +635,8 @@ type payment struct {
 
 	Schedule paymentHasOneSchedule
 
+	Allocations paymentHasManyAllocations
+
 	fieldMap map[string]field.Expr
 }
 
@@ -594,7 +654,7 @@ func (p *payment) updateTableName(table string) *payment {
 	p.ALL = field.NewAsterisk(table)
 	p.PaymentID = field.NewInt64(table, "payment_id")
 	p.NomorBukti = field.NewString(table, "nomor_bukti")
-	p.JumlahBayar = field.NewFloat64(table, "jumlah_bayar")
+	p.JumlahBayar = field.NewField(table, "jumlah_bayar")
 	p.TanggalBayar = field.NewTime(table, "tanggal_bayar")
 	p.MetodePembayaran = field.NewString(table, "metode_pembayaran")
 	p.Provider = field.NewString(table, "provider")
@@ -617,7 +677,7 @@ func (p *payment) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *payment) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 11)
+	p.fieldMap = make(map[string]field.Expr, 12)
 	p.fieldMap["payment_id"] = p.PaymentID
 	p.fieldMap["nomor_bukti"] = p.NomorBukti
 	p.fieldMap["jumlah_bayar"] = p.JumlahBayar
@@ -636,6 +696,8 @@ func (p payment) clone(db *gorm.DB) payment {
 	p.Contract.db.Statement.ConnPool = db.Statement.ConnPool
 	p.Schedule.db = db.Session(&gorm.Session{Initialized: true})
 	p.Schedule.db.Statement.ConnPool = db.Statement.ConnPool
+	p.Allocations.db = db.Session(&gorm.Session{Initialized: true})
+	p.Allocations.db.Statement.ConnPool = db.Statement.ConnPool
 	return p
 }
 
@@ -643,6 +705,7 @@ func (p payment) replaceDB(db *gorm.DB) payment {
 	p.paymentDo.ReplaceDB(db)
 	p.Contract.db = db.Session(&gorm.Session{})
 	p.Schedule.db = db.Session(&gorm.Session{})
+	p.Allocations.db = db.Session(&gorm.Session{})
 	return p
 }
 
@@ -769,6 +832,15 @@ type paymentHasOneContract struct {
 			Schedule struct {
 				field.RelationField
 			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
 		}
 	}
 	Payments struct {
@@ -780,6 +852,12 @@ type paymentHasOneContract struct {
 			field.RelationField
 		}
 	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a paymentHasOneContract) Where(conds ...field.Expr) *paymentHasOneContract {
@@ -938,6 +1016,87 @@ func (a paymentHasOneScheduleTx) Unscoped() *paymentHasOneScheduleTx {
 	return &a
 }
 
+type paymentHasManyAllocations struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a paymentHasManyAllocations) Where(conds ...field.Expr) *paymentHasManyAllocations {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a paymentHasManyAllocations) WithContext(ctx context.Context) *paymentHasManyAllocations {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a paymentHasManyAllocations) Session(session *gorm.Session) *paymentHasManyAllocations {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a paymentHasManyAllocations) Model(m *models.Payment) *paymentHasManyAllocationsTx {
+	return &paymentHasManyAllocationsTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a paymentHasManyAllocations) Unscoped() *paymentHasManyAllocations {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type paymentHasManyAllocationsTx struct{ tx *gorm.Association }
+
+func (a paymentHasManyAllocationsTx) Find() (result []*models.PaymentAllocation, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a paymentHasManyAllocationsTx) Append(values ...*models.PaymentAllocation) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a paymentHasManyAllocationsTx) Replace(values ...*models.PaymentAllocation) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a paymentHasManyAllocationsTx) Delete(values ...*models.PaymentAllocation) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a paymentHasManyAllocationsTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a paymentHasManyAllocationsTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a paymentHasManyAllocationsTx) Unscoped() *paymentHasManyAllocationsTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
 type paymentDo struct{ gen.DO }
 
 type IPaymentDo interface {
@@ -0,0 +1,1333 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newPayoffQuote(db *gorm.DB, opts ...gen.DOOption) payoffQuote {
+	_payoffQuote := payoffQuote{}
+
+	_payoffQuote.payoffQuoteDo.UseDB(db, opts...)
+	_payoffQuote.payoffQuoteDo.UseModel(&models.PayoffQuote{})
+
+	tableName := _payoffQuote.payoffQuoteDo.TableName()
+	_payoffQuote.ALL = field.NewAsterisk(tableName)
+	_payoffQuote.QuoteID = field.NewInt64(tableName, "quote_id")
+	_payoffQuote.QuoteDate = field.NewTime(tableName, "quote_date")
+	_payoffQuote.ValidUntil = field.NewTime(tableName, "valid_until")
+	_payoffQuote.RebatePolicy = field.NewString(tableName, "rebate_policy")
+	_payoffQuote.RemainingInstallments = field.NewInt(tableName, "remaining_installments")
+	_payoffQuote.RemainingPrincipal = field.NewField(tableName, "remaining_principal")
+	_payoffQuote.OutstandingMargin = field.NewField(tableName, "outstanding_margin")
+	_payoffQuote.UnearnedMargin = field.NewField(tableName, "unearned_margin")
+	_payoffQuote.MarginRebate = field.NewField(tableName, "margin_rebate")
+	_payoffQuote.Penalties = field.NewField(tableName, "penalties")
+	_payoffQuote.Fee = field.NewField(tableName, "fee")
+	_payoffQuote.CreditBalance = field.NewField(tableName, "credit_balance")
+	_payoffQuote.PayoffAmount = field.NewField(tableName, "payoff_amount")
+	_payoffQuote.SettledAt = field.NewTime(tableName, "settled_at")
+	_payoffQuote.CreatedAt = field.NewTime(tableName, "created_at")
+	_payoffQuote.ContractID = field.NewInt64(tableName, "contract_id")
+	_payoffQuote.PaymentID = field.NewInt64(tableName, "payment_id")
+	_payoffQuote.Contract = payoffQuoteHasOneContract{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Contract", "models.LeasingContract"),
+		Customer: struct {
+			field.RelationField
+			Location struct {
+				field.RelationField
+				Kelurahan struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}
+			}
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Customer", "models.Customer"),
+			Location: struct {
+				field.RelationField
+				Kelurahan struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Customer.Location", "models.Location"),
+				Kelurahan: struct {
+					field.RelationField
+					Kecamatan struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}
+					Locations struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan", "models.Kelurahan"),
+					Kecamatan: struct {
+						field.RelationField
+						Kabupaten struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}
+						Kelurahan struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan", "models.Kecamatan"),
+						Kabupaten: struct {
+							field.RelationField
+							Province struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}
+							Kecamatan struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten", "models.Kabupaten"),
+							Province: struct {
+								field.RelationField
+								Kabupaten struct {
+									field.RelationField
+								}
+							}{
+								RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province", "models.Province"),
+								Kabupaten: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Province.Kabupaten", "models.Kabupaten"),
+								},
+							},
+							Kecamatan: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kabupaten.Kecamatan", "models.Kecamatan"),
+							},
+						},
+						Kelurahan: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Kecamatan.Kelurahan", "models.Kelurahan"),
+						},
+					},
+					Locations: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.Customer.Location.Kelurahan.Locations", "models.Location"),
+					},
+				},
+			},
+			LeasingContracts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.Customer.LeasingContracts", "models.LeasingContract"),
+			},
+		},
+		Motor: struct {
+			field.RelationField
+			MotorTypeRef struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}
+			MotorAssets struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Motor", "models.Motor"),
+			MotorTypeRef: struct {
+				field.RelationField
+				Motors struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Motor.MotorTypeRef", "models.MotorType"),
+				Motors: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.Motor.MotorTypeRef.Motors", "models.Motor"),
+				},
+			},
+			MotorAssets: struct {
+				field.RelationField
+				Motor struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.Motor.MotorAssets", "models.MotorAsset"),
+				Motor: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.Motor.MotorAssets.Motor", "models.Motor"),
+				},
+			},
+		},
+		Product: struct {
+			field.RelationField
+			LeasingContracts struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.Product", "models.LeasingProduct"),
+			LeasingContracts: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.Product.LeasingContracts", "models.LeasingContract"),
+			},
+		},
+		LeasingTasks: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			LeasingAttribute struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.LeasingTasks", "models.LeasingTask"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.Contract", "models.LeasingContract"),
+			},
+			Role: struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.Role", "models.Role"),
+				UserRoles: struct {
+					field.RelationField
+					User struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}
+					Role struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles", "models.UserRole"),
+					User: struct {
+						field.RelationField
+						UserOAuthProviders struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}
+						UserRoles struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User", "models.User"),
+						UserOAuthProviders: struct {
+							field.RelationField
+							User struct {
+								field.RelationField
+							}
+							Provider struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders", "models.UserOAuthProvider"),
+							User: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.User", "models.User"),
+							},
+							Provider: struct {
+								field.RelationField
+								UserOAuthProviders struct {
+									field.RelationField
+								}
+							}{
+								RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+								UserOAuthProviders: struct {
+									field.RelationField
+								}{
+									RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+								},
+							},
+						},
+						UserRoles: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.User.UserRoles", "models.UserRole"),
+						},
+					},
+					Role: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.UserRoles.Role", "models.Role"),
+					},
+				},
+				RolePermissions: struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions", "models.RolePermission"),
+					Role: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Role", "models.Role"),
+					},
+					Permission: struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Permission", "models.Permission"),
+						RolePermissions: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Contract.LeasingTasks.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+						},
+					},
+				},
+			},
+			LeasingAttribute: struct {
+				field.RelationField
+				Task struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Contract.LeasingTasks.LeasingAttribute", "models.LeasingTaskAttribute"),
+				Task: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.LeasingTasks.LeasingAttribute.Task", "models.LeasingTask"),
+				},
+			},
+		},
+		PaymentSchedules: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Payments struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Contract.PaymentSchedules", "models.PaymentSchedule"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.PaymentSchedules.Contract", "models.LeasingContract"),
+			},
+			Payments: struct {
+				field.RelationField
+				Contract struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+				Allocations struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Contract.PaymentSchedules.Payments", "models.Payment"),
+				Contract: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Contract", "models.LeasingContract"),
+				},
+				Schedule: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Schedule", "models.PaymentSchedule"),
+				},
+				Allocations: struct {
+					field.RelationField
+					Payment struct {
+						field.RelationField
+					}
+					Schedule struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations", "models.PaymentAllocation"),
+					Payment: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Payment", "models.Payment"),
+					},
+					Schedule: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Contract.PaymentSchedules.Payments.Allocations.Schedule", "models.PaymentSchedule"),
+					},
+				},
+			},
+		},
+		Payments: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Contract.Payments", "models.Payment"),
+		},
+		ContractDocuments: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.ContractDocuments", "models.LeasingContractDocument"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.ContractDocuments.Contract", "models.LeasingContract"),
+			},
+		},
+		StatusHistory: struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Contract.StatusHistory", "models.ContractStatusHistory"),
+			Contract: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Contract.StatusHistory.Contract", "models.LeasingContract"),
+			},
+		},
+	}
+
+	_payoffQuote.Payment = payoffQuoteHasOnePayment{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Payment", "models.Payment"),
+	}
+
+	_payoffQuote.fillFieldMap()
+
+	return _payoffQuote
+}
+
+type payoffQuote struct {
+	payoffQuoteDo
+
+	ALL                   field.Asterisk
+	QuoteID               field.Int64
+	QuoteDate             field.Time
+	ValidUntil            field.Time
+	RebatePolicy          field.String
+	RemainingInstallments field.Int
+	RemainingPrincipal    field.Field
+	OutstandingMargin     field.Field
+	UnearnedMargin        field.Field
+	MarginRebate          field.Field
+	Penalties             field.Field
+	Fee                   field.Field
+	CreditBalance         field.Field
+	PayoffAmount          field.Field
+	SettledAt             field.Time
+	CreatedAt             field.Time
+	ContractID            field.Int64
+	PaymentID             field.Int64
+	Contract              payoffQuoteHasOneContract
+
+	Payment payoffQuoteHasOnePayment
+
+	fieldMap map[string]field.Expr
+}
+
+func (p payoffQuote) Table(newTableName string) *payoffQuote {
+	p.payoffQuoteDo.UseTable(newTableName)
+	return p.updateTableName(newTableName)
+}
+
+func (p payoffQuote) As(alias string) *payoffQuote {
+	p.payoffQuoteDo.DO = *(p.payoffQuoteDo.As(alias).(*gen.DO))
+	return p.updateTableName(alias)
+}
+
+func (p *payoffQuote) updateTableName(table string) *payoffQuote {
+	p.ALL = field.NewAsterisk(table)
+	p.QuoteID = field.NewInt64(table, "quote_id")
+	p.QuoteDate = field.NewTime(table, "quote_date")
+	p.ValidUntil = field.NewTime(table, "valid_until")
+	p.RebatePolicy = field.NewString(table, "rebate_policy")
+	p.RemainingInstallments = field.NewInt(table, "remaining_installments")
+	p.RemainingPrincipal = field.NewField(table, "remaining_principal")
+	p.OutstandingMargin = field.NewField(table, "outstanding_margin")
+	p.UnearnedMargin = field.NewField(table, "unearned_margin")
+	p.MarginRebate = field.NewField(table, "margin_rebate")
+	p.Penalties = field.NewField(table, "penalties")
+	p.Fee = field.NewField(table, "fee")
+	p.CreditBalance = field.NewField(table, "credit_balance")
+	p.PayoffAmount = field.NewField(table, "payoff_amount")
+	p.SettledAt = field.NewTime(table, "settled_at")
+	p.CreatedAt = field.NewTime(table, "created_at")
+	p.ContractID = field.NewInt64(table, "contract_id")
+	p.PaymentID = field.NewInt64(table, "payment_id")
+
+	p.fillFieldMap()
+
+	return p
+}
+
+func (p *payoffQuote) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := p.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (p *payoffQuote) fillFieldMap() {
+	p.fieldMap = make(map[string]field.Expr, 19)
+	p.fieldMap["quote_id"] = p.QuoteID
+	p.fieldMap["quote_date"] = p.QuoteDate
+	p.fieldMap["valid_until"] = p.ValidUntil
+	p.fieldMap["rebate_policy"] = p.RebatePolicy
+	p.fieldMap["remaining_installments"] = p.RemainingInstallments
+	p.fieldMap["remaining_principal"] = p.RemainingPrincipal
+	p.fieldMap["outstanding_margin"] = p.OutstandingMargin
+	p.fieldMap["unearned_margin"] = p.UnearnedMargin
+	p.fieldMap["margin_rebate"] = p.MarginRebate
+	p.fieldMap["penalties"] = p.Penalties
+	p.fieldMap["fee"] = p.Fee
+	p.fieldMap["credit_balance"] = p.CreditBalance
+	p.fieldMap["payoff_amount"] = p.PayoffAmount
+	p.fieldMap["settled_at"] = p.SettledAt
+	p.fieldMap["created_at"] = p.CreatedAt
+	p.fieldMap["contract_id"] = p.ContractID
+	p.fieldMap["payment_id"] = p.PaymentID
+
+}
+
+func (p payoffQuote) clone(db *gorm.DB) payoffQuote {
+	p.payoffQuoteDo.ReplaceConnPool(db.Statement.ConnPool)
+	p.Contract.db = db.Session(&gorm.Session{Initialized: true})
+	p.Contract.db.Statement.ConnPool = db.Statement.ConnPool
+	p.Payment.db = db.Session(&gorm.Session{Initialized: true})
+	p.Payment.db.Statement.ConnPool = db.Statement.ConnPool
+	return p
+}
+
+func (p payoffQuote) replaceDB(db *gorm.DB) payoffQuote {
+	p.payoffQuoteDo.ReplaceDB(db)
+	p.Contract.db = db.Session(&gorm.Session{})
+	p.Payment.db = db.Session(&gorm.Session{})
+	return p
+}
+
+type payoffQuoteHasOneContract struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Customer struct {
+		field.RelationField
+		Location struct {
+			field.RelationField
+			Kelurahan struct {
+				field.RelationField
+				Kecamatan struct {
+					field.RelationField
+					Kabupaten struct {
+						field.RelationField
+						Province struct {
+							field.RelationField
+							Kabupaten struct {
+								field.RelationField
+							}
+						}
+						Kecamatan struct {
+							field.RelationField
+						}
+					}
+					Kelurahan struct {
+						field.RelationField
+					}
+				}
+				Locations struct {
+					field.RelationField
+				}
+			}
+		}
+		LeasingContracts struct {
+			field.RelationField
+		}
+	}
+	Motor struct {
+		field.RelationField
+		MotorTypeRef struct {
+			field.RelationField
+			Motors struct {
+				field.RelationField
+			}
+		}
+		MotorAssets struct {
+			field.RelationField
+			Motor struct {
+				field.RelationField
+			}
+		}
+	}
+	Product struct {
+		field.RelationField
+		LeasingContracts struct {
+			field.RelationField
+		}
+	}
+	LeasingTasks struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+		Role struct {
+			field.RelationField
+			UserRoles struct {
+				field.RelationField
+				User struct {
+					field.RelationField
+					UserOAuthProviders struct {
+						field.RelationField
+						User struct {
+							field.RelationField
+						}
+						Provider struct {
+							field.RelationField
+							UserOAuthProviders struct {
+								field.RelationField
+							}
+						}
+					}
+					UserRoles struct {
+						field.RelationField
+					}
+				}
+				Role struct {
+					field.RelationField
+				}
+			}
+			RolePermissions struct {
+				field.RelationField
+				Role struct {
+					field.RelationField
+				}
+				Permission struct {
+					field.RelationField
+					RolePermissions struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		LeasingAttribute struct {
+			field.RelationField
+			Task struct {
+				field.RelationField
+			}
+		}
+	}
+	PaymentSchedules struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+		Payments struct {
+			field.RelationField
+			Contract struct {
+				field.RelationField
+			}
+			Schedule struct {
+				field.RelationField
+			}
+			Allocations struct {
+				field.RelationField
+				Payment struct {
+					field.RelationField
+				}
+				Schedule struct {
+					field.RelationField
+				}
+			}
+		}
+	}
+	Payments struct {
+		field.RelationField
+	}
+	ContractDocuments struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
+	StatusHistory struct {
+		field.RelationField
+		Contract struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a payoffQuoteHasOneContract) Where(conds ...field.Expr) *payoffQuoteHasOneContract {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a payoffQuoteHasOneContract) WithContext(ctx context.Context) *payoffQuoteHasOneContract {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a payoffQuoteHasOneContract) Session(session *gorm.Session) *payoffQuoteHasOneContract {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a payoffQuoteHasOneContract) Model(m *models.PayoffQuote) *payoffQuoteHasOneContractTx {
+	return &payoffQuoteHasOneContractTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a payoffQuoteHasOneContract) Unscoped() *payoffQuoteHasOneContract {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type payoffQuoteHasOneContractTx struct{ tx *gorm.Association }
+
+func (a payoffQuoteHasOneContractTx) Find() (result *models.LeasingContract, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a payoffQuoteHasOneContractTx) Append(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a payoffQuoteHasOneContractTx) Replace(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a payoffQuoteHasOneContractTx) Delete(values ...*models.LeasingContract) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a payoffQuoteHasOneContractTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a payoffQuoteHasOneContractTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a payoffQuoteHasOneContractTx) Unscoped() *payoffQuoteHasOneContractTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type payoffQuoteHasOnePayment struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a payoffQuoteHasOnePayment) Where(conds ...field.Expr) *payoffQuoteHasOnePayment {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a payoffQuoteHasOnePayment) WithContext(ctx context.Context) *payoffQuoteHasOnePayment {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a payoffQuoteHasOnePayment) Session(session *gorm.Session) *payoffQuoteHasOnePayment {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a payoffQuoteHasOnePayment) Model(m *models.PayoffQuote) *payoffQuoteHasOnePaymentTx {
+	return &payoffQuoteHasOnePaymentTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a payoffQuoteHasOnePayment) Unscoped() *payoffQuoteHasOnePayment {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type payoffQuoteHasOnePaymentTx struct{ tx *gorm.Association }
+
+func (a payoffQuoteHasOnePaymentTx) Find() (result *models.Payment, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a payoffQuoteHasOnePaymentTx) Append(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a payoffQuoteHasOnePaymentTx) Replace(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a payoffQuoteHasOnePaymentTx) Delete(values ...*models.Payment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a payoffQuoteHasOnePaymentTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a payoffQuoteHasOnePaymentTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a payoffQuoteHasOnePaymentTx) Unscoped() *payoffQuoteHasOnePaymentTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type payoffQuoteDo struct{ gen.DO }
+
+type IPayoffQuoteDo interface {
+	gen.SubQuery
+	Debug() IPayoffQuoteDo
+	WithContext(ctx context.Context) IPayoffQuoteDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IPayoffQuoteDo
+	WriteDB() IPayoffQuoteDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IPayoffQuoteDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IPayoffQuoteDo
+	Not(conds ...gen.Condition) IPayoffQuoteDo
+	Or(conds ...gen.Condition) IPayoffQuoteDo
+	Select(conds ...field.Expr) IPayoffQuoteDo
+	Where(conds ...gen.Condition) IPayoffQuoteDo
+	Order(conds ...field.Expr) IPayoffQuoteDo
+	Distinct(cols ...field.Expr) IPayoffQuoteDo
+	Omit(cols ...field.Expr) IPayoffQuoteDo
+	Join(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo
+	Group(cols ...field.Expr) IPayoffQuoteDo
+	Having(conds ...gen.Condition) IPayoffQuoteDo
+	Limit(limit int) IPayoffQuoteDo
+	Offset(offset int) IPayoffQuoteDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IPayoffQuoteDo
+	Unscoped() IPayoffQuoteDo
+	Create(values ...*models.PayoffQuote) error
+	CreateInBatches(values []*models.PayoffQuote, batchSize int) error
+	Save(values ...*models.PayoffQuote) error
+	First() (*models.PayoffQuote, error)
+	Take() (*models.PayoffQuote, error)
+	Last() (*models.PayoffQuote, error)
+	Find() ([]*models.PayoffQuote, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.PayoffQuote, err error)
+	FindInBatches(result *[]*models.PayoffQuote, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.PayoffQuote) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IPayoffQuoteDo
+	Assign(attrs ...field.AssignExpr) IPayoffQuoteDo
+	Joins(fields ...field.RelationField) IPayoffQuoteDo
+	Preload(fields ...field.RelationField) IPayoffQuoteDo
+	FirstOrInit() (*models.PayoffQuote, error)
+	FirstOrCreate() (*models.PayoffQuote, error)
+	FindByPage(offset int, limit int) (result []*models.PayoffQuote, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IPayoffQuoteDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (p payoffQuoteDo) Debug() IPayoffQuoteDo {
+	return p.withDO(p.DO.Debug())
+}
+
+func (p payoffQuoteDo) WithContext(ctx context.Context) IPayoffQuoteDo {
+	return p.withDO(p.DO.WithContext(ctx))
+}
+
+func (p payoffQuoteDo) ReadDB() IPayoffQuoteDo {
+	return p.Clauses(dbresolver.Read)
+}
+
+func (p payoffQuoteDo) WriteDB() IPayoffQuoteDo {
+	return p.Clauses(dbresolver.Write)
+}
+
+func (p payoffQuoteDo) Session(config *gorm.Session) IPayoffQuoteDo {
+	return p.withDO(p.DO.Session(config))
+}
+
+func (p payoffQuoteDo) Clauses(conds ...clause.Expression) IPayoffQuoteDo {
+	return p.withDO(p.DO.Clauses(conds...))
+}
+
+func (p payoffQuoteDo) Returning(value interface{}, columns ...string) IPayoffQuoteDo {
+	return p.withDO(p.DO.Returning(value, columns...))
+}
+
+func (p payoffQuoteDo) Not(conds ...gen.Condition) IPayoffQuoteDo {
+	return p.withDO(p.DO.Not(conds...))
+}
+
+func (p payoffQuoteDo) Or(conds ...gen.Condition) IPayoffQuoteDo {
+	return p.withDO(p.DO.Or(conds...))
+}
+
+func (p payoffQuoteDo) Select(conds ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Select(conds...))
+}
+
+func (p payoffQuoteDo) Where(conds ...gen.Condition) IPayoffQuoteDo {
+	return p.withDO(p.DO.Where(conds...))
+}
+
+func (p payoffQuoteDo) Order(conds ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Order(conds...))
+}
+
+func (p payoffQuoteDo) Distinct(cols ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Distinct(cols...))
+}
+
+func (p payoffQuoteDo) Omit(cols ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Omit(cols...))
+}
+
+func (p payoffQuoteDo) Join(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Join(table, on...))
+}
+
+func (p payoffQuoteDo) LeftJoin(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.LeftJoin(table, on...))
+}
+
+func (p payoffQuoteDo) RightJoin(table schema.Tabler, on ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.RightJoin(table, on...))
+}
+
+func (p payoffQuoteDo) Group(cols ...field.Expr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Group(cols...))
+}
+
+func (p payoffQuoteDo) Having(conds ...gen.Condition) IPayoffQuoteDo {
+	return p.withDO(p.DO.Having(conds...))
+}
+
+func (p payoffQuoteDo) Limit(limit int) IPayoffQuoteDo {
+	return p.withDO(p.DO.Limit(limit))
+}
+
+func (p payoffQuoteDo) Offset(offset int) IPayoffQuoteDo {
+	return p.withDO(p.DO.Offset(offset))
+}
+
+func (p payoffQuoteDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IPayoffQuoteDo {
+	return p.withDO(p.DO.Scopes(funcs...))
+}
+
+func (p payoffQuoteDo) Unscoped() IPayoffQuoteDo {
+	return p.withDO(p.DO.Unscoped())
+}
+
+func (p payoffQuoteDo) Create(values ...*models.PayoffQuote) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Create(values)
+}
+
+func (p payoffQuoteDo) CreateInBatches(values []*models.PayoffQuote, batchSize int) error {
+	return p.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (p payoffQuoteDo) Save(values ...*models.PayoffQuote) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Save(values)
+}
+
+func (p payoffQuoteDo) First() (*models.PayoffQuote, error) {
+	if result, err := p.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PayoffQuote), nil
+	}
+}
+
+func (p payoffQuoteDo) Take() (*models.PayoffQuote, error) {
+	if result, err := p.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PayoffQuote), nil
+	}
+}
+
+func (p payoffQuoteDo) Last() (*models.PayoffQuote, error) {
+	if result, err := p.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PayoffQuote), nil
+	}
+}
+
+func (p payoffQuoteDo) Find() ([]*models.PayoffQuote, error) {
+	result, err := p.DO.Find()
+	return result.([]*models.PayoffQuote), err
+}
+
+func (p payoffQuoteDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.PayoffQuote, err error) {
+	buf := make([]*models.PayoffQuote, 0, batchSize)
+	err = p.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (p payoffQuoteDo) FindInBatches(result *[]*models.PayoffQuote, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return p.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (p payoffQuoteDo) Attrs(attrs ...field.AssignExpr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Attrs(attrs...))
+}
+
+func (p payoffQuoteDo) Assign(attrs ...field.AssignExpr) IPayoffQuoteDo {
+	return p.withDO(p.DO.Assign(attrs...))
+}
+
+func (p payoffQuoteDo) Joins(fields ...field.RelationField) IPayoffQuoteDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Joins(_f))
+	}
+	return &p
+}
+
+func (p payoffQuoteDo) Preload(fields ...field.RelationField) IPayoffQuoteDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Preload(_f))
+	}
+	return &p
+}
+
+func (p payoffQuoteDo) FirstOrInit() (*models.PayoffQuote, error) {
+	if result, err := p.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PayoffQuote), nil
+	}
+}
+
+func (p payoffQuoteDo) FirstOrCreate() (*models.PayoffQuote, error) {
+	if result, err := p.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.PayoffQuote), nil
+	}
+}
+
+func (p payoffQuoteDo) FindByPage(offset int, limit int) (result []*models.PayoffQuote, count int64, err error) {
+	result, err = p.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = p.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (p payoffQuoteDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = p.Count()
+	if err != nil {
+		return
+	}
+
+	err = p.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (p payoffQuoteDo) Scan(result interface{}) (err error) {
+	return p.DO.Scan(result)
+}
+
+func (p payoffQuoteDo) Delete(models ...*models.PayoffQuote) (result gen.ResultInfo, err error) {
+	return p.DO.Delete(models)
+}
+
+func (p *payoffQuoteDo) withDO(do gen.Dao) *payoffQuoteDo {
+	p.DO = *do.(*gen.DO)
+	return p
+}
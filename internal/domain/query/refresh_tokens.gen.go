@@ -0,0 +1,655 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+)
+
+func newRefreshToken(db *gorm.DB, opts ...gen.DOOption) refreshToken {
+	_refreshToken := refreshToken{}
+
+	_refreshToken.refreshTokenDo.UseDB(db, opts...)
+	_refreshToken.refreshTokenDo.UseModel(&models.RefreshToken{})
+
+	tableName := _refreshToken.refreshTokenDo.TableName()
+	_refreshToken.ALL = field.NewAsterisk(tableName)
+	_refreshToken.TokenID = field.NewInt64(tableName, "token_id")
+	_refreshToken.TokenHash = field.NewString(tableName, "token_hash")
+	_refreshToken.ExpiresAt = field.NewTime(tableName, "expires_at")
+	_refreshToken.RevokedAt = field.NewTime(tableName, "revoked_at")
+	_refreshToken.ReplacedByID = field.NewInt64(tableName, "replaced_by_id")
+	_refreshToken.CreatedAt = field.NewTime(tableName, "created_at")
+	_refreshToken.UserID = field.NewInt64(tableName, "user_id")
+	_refreshToken.User = refreshTokenHasOneUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+		UserOAuthProviders: struct {
+			field.RelationField
+			User struct {
+				field.RelationField
+			}
+			Provider struct {
+				field.RelationField
+				UserOAuthProviders struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("User.UserOAuthProviders", "models.UserOAuthProvider"),
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.UserOAuthProviders.User", "models.User"),
+			},
+			Provider: struct {
+				field.RelationField
+				UserOAuthProviders struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("User.UserOAuthProviders.Provider", "models.OAuthProvider"),
+				UserOAuthProviders: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.UserOAuthProviders.Provider.UserOAuthProviders", "models.UserOAuthProvider"),
+				},
+			},
+		},
+		UserRoles: struct {
+			field.RelationField
+			User struct {
+				field.RelationField
+			}
+			Role struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("User.UserRoles", "models.UserRole"),
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.UserRoles.User", "models.User"),
+			},
+			Role: struct {
+				field.RelationField
+				UserRoles struct {
+					field.RelationField
+				}
+				RolePermissions struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("User.UserRoles.Role", "models.Role"),
+				UserRoles: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.UserRoles.Role.UserRoles", "models.UserRole"),
+				},
+				RolePermissions: struct {
+					field.RelationField
+					Role struct {
+						field.RelationField
+					}
+					Permission struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("User.UserRoles.Role.RolePermissions", "models.RolePermission"),
+					Role: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.UserRoles.Role.RolePermissions.Role", "models.Role"),
+					},
+					Permission: struct {
+						field.RelationField
+						RolePermissions struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.UserRoles.Role.RolePermissions.Permission", "models.Permission"),
+						RolePermissions: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.UserRoles.Role.RolePermissions.Permission.RolePermissions", "models.RolePermission"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	_refreshToken.fillFieldMap()
+
+	return _refreshToken
+}
+
+type refreshToken struct {
+	refreshTokenDo
+
+	ALL          field.Asterisk
+	TokenID      field.Int64
+	TokenHash    field.String
+	ExpiresAt    field.Time
+	RevokedAt    field.Time
+	ReplacedByID field.Int64
+	CreatedAt    field.Time
+	UserID       field.Int64
+	User         refreshTokenHasOneUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (r refreshToken) Table(newTableName string) *refreshToken {
+	r.refreshTokenDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r refreshToken) As(alias string) *refreshToken {
+	r.refreshTokenDo.DO = *(r.refreshTokenDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *refreshToken) updateTableName(table string) *refreshToken {
+	r.ALL = field.NewAsterisk(table)
+	r.TokenID = field.NewInt64(table, "token_id")
+	r.TokenHash = field.NewString(table, "token_hash")
+	r.ExpiresAt = field.NewTime(table, "expires_at")
+	r.RevokedAt = field.NewTime(table, "revoked_at")
+	r.ReplacedByID = field.NewInt64(table, "replaced_by_id")
+	r.CreatedAt = field.NewTime(table, "created_at")
+	r.UserID = field.NewInt64(table, "user_id")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *refreshToken) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *refreshToken) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 8)
+	r.fieldMap["token_id"] = r.TokenID
+	r.fieldMap["token_hash"] = r.TokenHash
+	r.fieldMap["expires_at"] = r.ExpiresAt
+	r.fieldMap["revoked_at"] = r.RevokedAt
+	r.fieldMap["replaced_by_id"] = r.ReplacedByID
+	r.fieldMap["created_at"] = r.CreatedAt
+	r.fieldMap["user_id"] = r.UserID
+
+}
+
+func (r refreshToken) clone(db *gorm.DB) refreshToken {
+	r.refreshTokenDo.ReplaceConnPool(db.Statement.ConnPool)
+	r.User.db = db.Session(&gorm.Session{Initialized: true})
+	r.User.db.Statement.ConnPool = db.Statement.ConnPool
+	return r
+}
+
+func (r refreshToken) replaceDB(db *gorm.DB) refreshToken {
+	r.refreshTokenDo.ReplaceDB(db)
+	r.User.db = db.Session(&gorm.Session{})
+	return r
+}
+
+type refreshTokenHasOneUser struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	UserOAuthProviders struct {
+		field.RelationField
+		User struct {
+			field.RelationField
+		}
+		Provider struct {
+			field.RelationField
+			UserOAuthProviders struct {
+				field.RelationField
+			}
+		}
+	}
+	UserRoles struct {
+		field.RelationField
+		User struct {
+			field.RelationField
+		}
+		Role struct {
+			field.RelationField
+			UserRoles struct {
+				field.RelationField
+			}
+			RolePermissions struct {
+				field.RelationField
+				Role struct {
+					field.RelationField
+				}
+				Permission struct {
+					field.RelationField
+					RolePermissions struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+}
+
+func (a refreshTokenHasOneUser) Where(conds ...field.Expr) *refreshTokenHasOneUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a refreshTokenHasOneUser) WithContext(ctx context.Context) *refreshTokenHasOneUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a refreshTokenHasOneUser) Session(session *gorm.Session) *refreshTokenHasOneUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a refreshTokenHasOneUser) Model(m *models.RefreshToken) *refreshTokenHasOneUserTx {
+	return &refreshTokenHasOneUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a refreshTokenHasOneUser) Unscoped() *refreshTokenHasOneUser {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type refreshTokenHasOneUserTx struct{ tx *gorm.Association }
+
+func (a refreshTokenHasOneUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a refreshTokenHasOneUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a refreshTokenHasOneUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a refreshTokenHasOneUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a refreshTokenHasOneUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a refreshTokenHasOneUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a refreshTokenHasOneUserTx) Unscoped() *refreshTokenHasOneUserTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
+type refreshTokenDo struct{ gen.DO }
+
+type IRefreshTokenDo interface {
+	gen.SubQuery
+	Debug() IRefreshTokenDo
+	WithContext(ctx context.Context) IRefreshTokenDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRefreshTokenDo
+	WriteDB() IRefreshTokenDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRefreshTokenDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRefreshTokenDo
+	Not(conds ...gen.Condition) IRefreshTokenDo
+	Or(conds ...gen.Condition) IRefreshTokenDo
+	Select(conds ...field.Expr) IRefreshTokenDo
+	Where(conds ...gen.Condition) IRefreshTokenDo
+	Order(conds ...field.Expr) IRefreshTokenDo
+	Distinct(cols ...field.Expr) IRefreshTokenDo
+	Omit(cols ...field.Expr) IRefreshTokenDo
+	Join(table schema.Tabler, on ...field.Expr) IRefreshTokenDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRefreshTokenDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRefreshTokenDo
+	Group(cols ...field.Expr) IRefreshTokenDo
+	Having(conds ...gen.Condition) IRefreshTokenDo
+	Limit(limit int) IRefreshTokenDo
+	Offset(offset int) IRefreshTokenDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRefreshTokenDo
+	Unscoped() IRefreshTokenDo
+	Create(values ...*models.RefreshToken) error
+	CreateInBatches(values []*models.RefreshToken, batchSize int) error
+	Save(values ...*models.RefreshToken) error
+	First() (*models.RefreshToken, error)
+	Take() (*models.RefreshToken, error)
+	Last() (*models.RefreshToken, error)
+	Find() ([]*models.RefreshToken, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.RefreshToken, err error)
+	FindInBatches(result *[]*models.RefreshToken, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.RefreshToken) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRefreshTokenDo
+	Assign(attrs ...field.AssignExpr) IRefreshTokenDo
+	Joins(fields ...field.RelationField) IRefreshTokenDo
+	Preload(fields ...field.RelationField) IRefreshTokenDo
+	FirstOrInit() (*models.RefreshToken, error)
+	FirstOrCreate() (*models.RefreshToken, error)
+	FindByPage(offset int, limit int) (result []*models.RefreshToken, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Rows() (*sql.Rows, error)
+	Row() *sql.Row
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRefreshTokenDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r refreshTokenDo) Debug() IRefreshTokenDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r refreshTokenDo) WithContext(ctx context.Context) IRefreshTokenDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r refreshTokenDo) ReadDB() IRefreshTokenDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r refreshTokenDo) WriteDB() IRefreshTokenDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r refreshTokenDo) Session(config *gorm.Session) IRefreshTokenDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r refreshTokenDo) Clauses(conds ...clause.Expression) IRefreshTokenDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r refreshTokenDo) Returning(value interface{}, columns ...string) IRefreshTokenDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r refreshTokenDo) Not(conds ...gen.Condition) IRefreshTokenDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r refreshTokenDo) Or(conds ...gen.Condition) IRefreshTokenDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r refreshTokenDo) Select(conds ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r refreshTokenDo) Where(conds ...gen.Condition) IRefreshTokenDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r refreshTokenDo) Order(conds ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r refreshTokenDo) Distinct(cols ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r refreshTokenDo) Omit(cols ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r refreshTokenDo) Join(table schema.Tabler, on ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r refreshTokenDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r refreshTokenDo) RightJoin(table schema.Tabler, on ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r refreshTokenDo) Group(cols ...field.Expr) IRefreshTokenDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r refreshTokenDo) Having(conds ...gen.Condition) IRefreshTokenDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r refreshTokenDo) Limit(limit int) IRefreshTokenDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r refreshTokenDo) Offset(offset int) IRefreshTokenDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r refreshTokenDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRefreshTokenDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r refreshTokenDo) Unscoped() IRefreshTokenDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r refreshTokenDo) Create(values ...*models.RefreshToken) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r refreshTokenDo) CreateInBatches(values []*models.RefreshToken, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r refreshTokenDo) Save(values ...*models.RefreshToken) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r refreshTokenDo) First() (*models.RefreshToken, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RefreshToken), nil
+	}
+}
+
+func (r refreshTokenDo) Take() (*models.RefreshToken, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RefreshToken), nil
+	}
+}
+
+func (r refreshTokenDo) Last() (*models.RefreshToken, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RefreshToken), nil
+	}
+}
+
+func (r refreshTokenDo) Find() ([]*models.RefreshToken, error) {
+	result, err := r.DO.Find()
+	return result.([]*models.RefreshToken), err
+}
+
+func (r refreshTokenDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.RefreshToken, err error) {
+	buf := make([]*models.RefreshToken, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r refreshTokenDo) FindInBatches(result *[]*models.RefreshToken, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r refreshTokenDo) Attrs(attrs ...field.AssignExpr) IRefreshTokenDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r refreshTokenDo) Assign(attrs ...field.AssignExpr) IRefreshTokenDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r refreshTokenDo) Joins(fields ...field.RelationField) IRefreshTokenDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r refreshTokenDo) Preload(fields ...field.RelationField) IRefreshTokenDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r refreshTokenDo) FirstOrInit() (*models.RefreshToken, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RefreshToken), nil
+	}
+}
+
+func (r refreshTokenDo) FirstOrCreate() (*models.RefreshToken, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.RefreshToken), nil
+	}
+}
+
+func (r refreshTokenDo) FindByPage(offset int, limit int) (result []*models.RefreshToken, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r refreshTokenDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r refreshTokenDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r refreshTokenDo) Delete(models ...*models.RefreshToken) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *refreshTokenDo) withDO(do gen.Dao) *refreshTokenDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
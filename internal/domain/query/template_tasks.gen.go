@@ -30,6 +30,7 @@ func newTemplateTask(db *gorm.DB, opts ...gen.DOOption) templateTask {
 	_templateTask.ALL = field.NewAsterisk(tableName)
 	_templateTask.TetaID = field.NewInt64(tableName, "teta_id")
 	_templateTask.TetaName = field.NewString(tableName, "teta_name")
+	_templateTask.TetaCode = field.NewString(tableName, "teta_code")
 	_templateTask.TetaRoleID = field.NewInt64(tableName, "teta_role_id")
 	_templateTask.Attributes = templateTaskHasManyAttributes{
 		db: db.Session(&gorm.Session{}),
@@ -267,6 +268,7 @@ type templateTask struct {
 	ALL        field.Asterisk
 	TetaID     field.Int64
 	TetaName   field.String
+	TetaCode   field.String
 	TetaRoleID field.Int64
 	Attributes templateTaskHasManyAttributes
 
@@ -289,6 +291,7 @@ func (t *templateTask) updateTableName(table string) *templateTask {
 	t.ALL = field.NewAsterisk(table)
 	t.TetaID = field.NewInt64(table, "teta_id")
 	t.TetaName = field.NewString(table, "teta_name")
+	t.TetaCode = field.NewString(table, "teta_code")
 	t.TetaRoleID = field.NewInt64(table, "teta_role_id")
 
 	t.fillFieldMap()
@@ -306,9 +309,10 @@ func (t *templateTask) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (t *templateTask) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 5)
+	t.fieldMap = make(map[string]field.Expr, 6)
 	t.fieldMap["teta_id"] = t.TetaID
 	t.fieldMap["teta_name"] = t.TetaName
+	t.fieldMap["teta_code"] = t.TetaCode
 	t.fieldMap["teta_role_id"] = t.TetaRoleID
 
 }
@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type MotorTypeDTO struct {
 	MotyID   int64  `json:"moty_id"`
@@ -8,19 +12,19 @@ type MotorTypeDTO struct {
 }
 
 type MotorDTO struct {
-	MotorID     int64     `json:"motor_id"`
-	Merk        string    `json:"merk"`
-	MotorType   string    `json:"motor_type"`
-	Tahun       int16     `json:"tahun"`
-	Warna       string    `json:"warna"`
-	NomorRangka string    `json:"nomor_rangka"`
-	NomorMesin  string    `json:"nomor_mesin"`
-	CCMesin     string    `json:"cc_mesin"`
-	NomorPolisi string    `json:"nomor_polisi"`
-	StatusUnit  string    `json:"status_unit"`
-	HargaOTR    float64   `json:"harga_otr"`
-	CreatedAt   time.Time `json:"created_at"`
-	MotorMotyID int64     `json:"motor_moty_id"`
+	MotorID     int64        `json:"motor_id"`
+	Merk        string       `json:"merk"`
+	MotorType   string       `json:"motor_type"`
+	Tahun       int16        `json:"tahun"`
+	Warna       string       `json:"warna"`
+	NomorRangka string       `json:"nomor_rangka"`
+	NomorMesin  string       `json:"nomor_mesin"`
+	CCMesin     string       `json:"cc_mesin"`
+	NomorPolisi string       `json:"nomor_polisi"`
+	StatusUnit  string       `json:"status_unit"`
+	HargaOTR    money.Amount `json:"harga_otr"`
+	CreatedAt   time.Time    `json:"created_at"`
+	MotorMotyID int64        `json:"motor_moty_id"`
 }
 
 type MotorAssetDTO struct {
@@ -29,20 +33,32 @@ type MotorAssetDTO struct {
 	FileSize    float64 `json:"file_size"`
 	FileType    string  `json:"file_type"`
 	FileURL     string  `json:"file_url"`
+	StoragePath *string `json:"storage_path"`
+	Checksum    *string `json:"checksum"`
 	MoasMotorID int64   `json:"moas_motor_id"`
 }
 
 type CustomerDTO struct {
-	CustomerID   int64     `json:"customer_id"`
-	NIK          string    `json:"nik"`
-	NamaLengkap  string    `json:"nama_lengkap"`
-	TanggalLahir time.Time `json:"tanggal_lahir"`
-	NoHP         string    `json:"no_hp"`
-	Email        string    `json:"email"`
-	Pekerjaan    string    `json:"pekerjaan"`
-	Perusahaan   *string   `json:"perusahaan"`
-	Salary       float64   `json:"salary"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-	LocationID   int64     `json:"location_id"`
+	CustomerID   int64        `json:"customer_id"`
+	NIK          string       `json:"nik"`
+	NamaLengkap  string       `json:"nama_lengkap"`
+	TanggalLahir time.Time    `json:"tanggal_lahir"`
+	NoHP         string       `json:"no_hp"`
+	Email        string       `json:"email"`
+	Pekerjaan    string       `json:"pekerjaan"`
+	Perusahaan   *string      `json:"perusahaan"`
+	Salary       money.Amount `json:"salary"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+	LocationID   int64        `json:"location_id"`
+}
+
+type CustomerBlacklistDTO struct {
+	BlacklistID int64      `json:"blacklist_id"`
+	NIK         string     `json:"nik"`
+	Reason      string     `json:"reason"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CustomerID  *int64     `json:"customer_id"`
 }
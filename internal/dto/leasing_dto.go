@@ -1,43 +1,87 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type LeasingProductDTO struct {
-	ProductID   int64     `json:"product_id"`
-	KodeProduk  string    `json:"kode_produk"`
-	NamaProduk  string    `json:"nama_produk"`
-	TenorBulan  int16     `json:"tenor_bulan"`
-	DPPersenMin float64   `json:"dp_persen_min"`
-	DPPersenMax float64   `json:"dp_persen_max"`
-	BungaFlat   float64   `json:"bunga_flat"`
-	AdminFee    float64   `json:"admin_fee"`
-	Asuransi    bool      `json:"asuransi"`
-	CreatedAt   time.Time `json:"created_at"`
+	ProductID        int64        `json:"product_id"`
+	KodeProduk       string       `json:"kode_produk"`
+	NamaProduk       string       `json:"nama_produk"`
+	TenorBulan       int16        `json:"tenor_bulan"`
+	DPPersenMin      float64      `json:"dp_persen_min"`
+	DPPersenMax      float64      `json:"dp_persen_max"`
+	BungaFlat        float64      `json:"bunga_flat"`
+	MetodeBunga      string       `json:"metode_bunga"`
+	BungaEfektif     float64      `json:"bunga_efektif"`
+	AdminFee         money.Amount `json:"admin_fee"`
+	AdminFeeDibiayai bool         `json:"admin_fee_dibiayai"`
+	Asuransi         bool         `json:"asuransi"`
+	AsuransiDibiayai bool         `json:"asuransi_dibiayai"`
+	BiayaFidusia     money.Amount `json:"biaya_fidusia"`
+	FidusiaDibiayai  bool         `json:"fidusia_dibiayai"`
+	DendaTipe        string       `json:"denda_tipe"`
+	DendaNilai       float64      `json:"denda_nilai"`
+	DendaGraceHari   int16        `json:"denda_grace_hari"`
+	DendaMaks        money.Amount `json:"denda_maks"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+type InsuranceRateDTO struct {
+	RateID     int64     `json:"rate_id"`
+	TenorBulan int16     `json:"tenor_bulan"`
+	RatePersen float64   `json:"rate_persen"`
+	CreatedAt  time.Time `json:"created_at"`
+	MotyID     *int64    `json:"moty_id"`
 }
 
 type LeasingContractDTO struct {
-	ContractID        int64      `json:"contract_id"`
-	ContractNumber    *string    `json:"contract_number"`
-	RequestDate       time.Time  `json:"request_date"`
-	TanggalAkad       *time.Time `json:"tanggal_akad"`
-	TanggalMulaiCicil time.Time  `json:"tanggal_mulai_cicil"`
-	TenorBulan        int16      `json:"tenor_bulan"`
-	NilaiKendaraan    float64    `json:"nilai_kendaraan"`
-	DPDibayar         float64    `json:"dp_dibayar"`
-	PokokPinjaman     float64    `json:"pokok_pinjaman"`
-	TotalPinjaman     float64    `json:"total_pinjaman"`
-	CicilanPerBulan   float64    `json:"cicilan_per_bulan"`
-	Status            string     `json:"status"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
-	CustomerID        int64      `json:"customer_id"`
-	MotorID           int64      `json:"motor_id"`
-	ProductID         int64      `json:"product_id"`
+	ContractID        int64        `json:"contract_id"`
+	ContractNumber    *string      `json:"contract_number"`
+	RequestDate       time.Time    `json:"request_date"`
+	TanggalAkad       *time.Time   `json:"tanggal_akad"`
+	TanggalMulaiCicil time.Time    `json:"tanggal_mulai_cicil"`
+	TenorBulan        int16        `json:"tenor_bulan"`
+	NilaiKendaraan    money.Amount `json:"nilai_kendaraan"`
+	DPDibayar         money.Amount `json:"dp_dibayar"`
+	PokokPinjaman     money.Amount `json:"pokok_pinjaman"`
+	TotalPinjaman     money.Amount `json:"total_pinjaman"`
+	CicilanPerBulan   money.Amount `json:"cicilan_per_bulan"`
+	MetodeBunga       string       `json:"metode_bunga"`
+	BungaFlat         float64      `json:"bunga_flat"`
+	BungaEfektif      float64      `json:"bunga_efektif"`
+	SaldoKredit       money.Amount `json:"saldo_kredit"`
+	AdminFee          money.Amount `json:"admin_fee"`
+	AsuransiPremi     money.Amount `json:"asuransi_premi"`
+	BiayaFidusia      money.Amount `json:"biaya_fidusia"`
+	BiayaDibiayai     money.Amount `json:"biaya_dibiayai"`
+	BiayaDimuka       money.Amount `json:"biaya_dimuka"`
+	Status            string       `json:"status"`
+	ManualReview      bool         `json:"manual_review"`
+	ReviewReasons     *string      `json:"review_reasons"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+	CustomerID        int64        `json:"customer_id"`
+	MotorID           int64        `json:"motor_id"`
+	ProductID         int64        `json:"product_id"`
+}
+
+type ContractStatusHistoryDTO struct {
+	HistoryID  int64     `json:"history_id"`
+	FromStatus *string   `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason"`
+	ActorID    *int64    `json:"actor_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	ContractID int64     `json:"contract_id"`
 }
 
 type LeasingTaskDTO struct {
 	TaskID          int64      `json:"task_id"`
 	TaskName        string     `json:"task_name"`
+	TaskCode        *string    `json:"task_code"`
 	StartDate       time.Time  `json:"startdate"`
 	EndDate         time.Time  `json:"enddate"`
 	ActualStartDate *time.Time `json:"actual_startdate"`
@@ -46,6 +90,8 @@ type LeasingTaskDTO struct {
 	Status          string     `json:"status"`
 	ContractID      int64      `json:"contract_id"`
 	RoleID          int64      `json:"role_id"`
+	CompletedBy     *int64     `json:"completed_by"`
+	CompletedAt     *time.Time `json:"completed_at"`
 }
 
 type LeasingTaskAttributeDTO struct {
@@ -57,10 +103,13 @@ type LeasingTaskAttributeDTO struct {
 }
 
 type LeasingContractDocumentDTO struct {
-	LocID      int64   `json:"loc_id"`
-	FileName   string  `json:"file_name"`
-	FileSize   float64 `json:"file_size"`
-	FileType   string  `json:"file_type"`
-	FileURL    string  `json:"file_url"`
-	ContractID int64   `json:"contract_id"`
+	LocID       int64   `json:"loc_id"`
+	FileName    string  `json:"file_name"`
+	FileSize    float64 `json:"file_size"`
+	FileType    string  `json:"file_type"`
+	FileURL     string  `json:"file_url"`
+	DocType     string  `json:"doc_type"`
+	StoragePath *string `json:"storage_path"`
+	Checksum    *string `json:"checksum"`
+	ContractID  int64   `json:"contract_id"`
 }
@@ -33,9 +33,10 @@ type LocationDTO struct {
 }
 
 type TemplateTaskDTO struct {
-	TetaID     int64  `json:"teta_id"`
-	TetaName   string `json:"teta_name"`
-	TetaRoleID int64  `json:"teta_role_id"`
+	TetaID     int64   `json:"teta_id"`
+	TetaName   string  `json:"teta_name"`
+	TetaCode   *string `json:"teta_code"`
+	TetaRoleID int64   `json:"teta_role_id"`
 }
 
 type TemplateTaskAttributeDTO struct {
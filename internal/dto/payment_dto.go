@@ -1,28 +1,44 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 type PaymentScheduleDTO struct {
-	ScheduleID       int64      `json:"schedule_id"`
-	AngsuranKe       int16      `json:"angsuran_ke"`
-	JatuhTempo       time.Time  `json:"jatuh_tempo"`
-	Pokok            float64    `json:"pokok"`
-	Margin           float64    `json:"margin"`
-	TotalTagihan     float64    `json:"total_tagihan"`
-	StatusPembayaran string     `json:"status_pembayaran"`
-	TanggalBayar     *time.Time `json:"tanggal_bayar"`
-	CreatedAt        time.Time  `json:"created_at"`
-	ContractID       int64      `json:"contract_id"`
+	ScheduleID       int64        `json:"schedule_id"`
+	AngsuranKe       int16        `json:"angsuran_ke"`
+	JatuhTempo       time.Time    `json:"jatuh_tempo"`
+	Pokok            money.Amount `json:"pokok"`
+	Margin           money.Amount `json:"margin"`
+	TotalTagihan     money.Amount `json:"total_tagihan"`
+	Terbayar         money.Amount `json:"terbayar"`
+	Denda            money.Amount `json:"denda"`
+	DendaTerbayar    money.Amount `json:"denda_terbayar"`
+	StatusPembayaran string       `json:"status_pembayaran"`
+	TanggalBayar     *time.Time   `json:"tanggal_bayar"`
+	CreatedAt        time.Time    `json:"created_at"`
+	ContractID       int64        `json:"contract_id"`
 }
 
 type PaymentDTO struct {
-	PaymentID        int64     `json:"payment_id"`
-	NomorBukti       string    `json:"nomor_bukti"`
-	JumlahBayar      float64   `json:"jumlah_bayar"`
-	TanggalBayar     time.Time `json:"tanggal_bayar"`
-	MetodePembayaran string    `json:"metode_pembayaran"`
-	Provider         string    `json:"provider"`
-	CreatedAt        time.Time `json:"created_at"`
-	ContractID       int64     `json:"contract_id"`
-	ScheduleID       *int64    `json:"schedule_id"`
+	PaymentID        int64        `json:"payment_id"`
+	NomorBukti       string       `json:"nomor_bukti"`
+	JumlahBayar      money.Amount `json:"jumlah_bayar"`
+	TanggalBayar     time.Time    `json:"tanggal_bayar"`
+	MetodePembayaran string       `json:"metode_pembayaran"`
+	Provider         string       `json:"provider"`
+	CreatedAt        time.Time    `json:"created_at"`
+	ContractID       int64        `json:"contract_id"`
+	ScheduleID       *int64       `json:"schedule_id"`
+}
+
+type PaymentAllocationDTO struct {
+	AllocationID int64        `json:"allocation_id"`
+	Kind         string       `json:"kind"`
+	Amount       money.Amount `json:"amount"`
+	CreatedAt    time.Time    `json:"created_at"`
+	PaymentID    int64        `json:"payment_id"`
+	ScheduleID   *int64       `json:"schedule_id"`
 }
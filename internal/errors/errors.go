@@ -1,16 +1,30 @@
 package errs
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	ErrInvalidInput      = errors.New("invalid input")
 	ErrInvalidPagination = errors.New("invalid pagination parameters")
 	ErrInvalidSort       = errors.New("invalid sort parameters")
 	ErrInvalidSearch     = errors.New("search name cannot be empty")
+	ErrInvalidFilter     = errors.New("invalid filter parameters")
+	ErrInvalidCursor     = errors.New("invalid or stale cursor")
+	ErrInvalidPreload    = errors.New("invalid preload parameters")
+	ErrInvalidFields     = errors.New("invalid fields parameters")
 	ErrInvalidEmail      = errors.New("email already exist")
 	ErrInvalidPassword   = errors.New("invalid password")
 	ErrInvalidDecision   = errors.New("invalid workflow decision")
 
+	// authentication
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrAccountLocked      = errors.New("account is temporarily locked")
+	ErrAccountInactive    = errors.New("account is inactive")
+	ErrInvalidToken       = errors.New("invalid or expired token")
+
 	// when create
 	ErrCreateUser = errors.New("error when create user")
 	ErrAssignRole = errors.New("error when assigned user role")
@@ -23,4 +37,102 @@ var (
 	ErrContractNotApproved     = errors.New("contract must be in approved status")
 	ErrDPOutOfRange            = errors.New("down payment is outside allowed product range")
 	ErrInvalidPaymentAmount    = errors.New("invalid payment amount")
+	ErrPaymentScheduleLocked   = errors.New("payment schedule already has recorded payments")
+	ErrTaskRoleMismatch        = errors.New("user does not hold the role assigned to this workflow task")
+	ErrWorkflowStepNotFound    = errors.New("workflow step not found")
+	ErrContractOutstanding     = errors.New("contract still has unpaid installments")
+	ErrNoPaymentSchedule       = errors.New("contract has no payment schedule")
+	ErrContractOverdue         = errors.New("contract still has overdue installments")
+	ErrContractNotOverdue      = errors.New("contract has no overdue installments")
+	ErrPayoffUnderpaid         = errors.New("payment does not cover the payoff amount")
+	ErrPayoffQuoteExpired      = errors.New("payoff quote has expired, request a new quote")
+	ErrPayoffQuoteStale        = errors.New("payoff quote no longer matches the contract, request a new quote")
+	ErrInsuranceRateNotFound   = errors.New("no insurance rate configured for motor type and tenor")
+	ErrScheduleNotReconciled   = errors.New("payment schedule does not reconcile with contract totals")
+	ErrApplicationRejected     = errors.New("application rejected by pre-submission checks")
+
+	// file upload
+	ErrFileTooLarge       = errors.New("file exceeds the allowed size")
+	ErrFileTypeNotAllowed = errors.New("file type is not allowed")
+	ErrFileMissing        = errors.New("stored file is missing")
+	ErrSignedURLInvalid   = errors.New("signed url is invalid or expired")
 )
+
+// WorkflowStepNotFoundError reports a contract whose task list lacks a step required by the workflow.
+type WorkflowStepNotFoundError struct {
+	ContractID int64
+	StepCode   string
+}
+
+func (e *WorkflowStepNotFoundError) Error() string {
+	return fmt.Sprintf("workflow step %q not found for contract %d", e.StepCode, e.ContractID)
+}
+
+func (e *WorkflowStepNotFoundError) Unwrap() error { return ErrWorkflowStepNotFound }
+
+// ApplicationRejectedError lists the pre-submission checks that rejected a customer's application.
+type ApplicationRejectedError struct {
+	CustomerID int64
+	Reasons    []string
+}
+
+func (e *ApplicationRejectedError) Error() string {
+	return fmt.Sprintf("application for customer %d rejected: %s", e.CustomerID, strings.Join(e.Reasons, ", "))
+}
+
+func (e *ApplicationRejectedError) Unwrap() error { return ErrApplicationRejected }
+
+// SortError reports a sort_by value outside the resource's sortable columns.
+type SortError struct {
+	Field   string
+	Allowed []string
+}
+
+func (e *SortError) Error() string {
+	if len(e.Allowed) == 0 {
+		return fmt.Sprintf("cannot sort by %q: this resource has no sortable fields", e.Field)
+	}
+	return fmt.Sprintf("cannot sort by %q, allowed: %s", e.Field, strings.Join(e.Allowed, ", "))
+}
+
+func (e *SortError) Unwrap() error { return ErrInvalidSort }
+
+// FilterError reports a list query parameter that is not a whitelisted filter or carries a bad value.
+// Allowed lists the filter fields the resource accepts.
+type FilterError struct {
+	Field   string
+	Reason  string
+	Allowed []string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("invalid filter %q: %s", e.Field, e.Reason)
+}
+
+func (e *FilterError) Unwrap() error { return ErrInvalidFilter }
+
+// PreloadError reports a preload relation the resource does not expose or that nests too deep.
+// Allowed lists the relations the resource accepts.
+type PreloadError struct {
+	Relation string
+	Reason   string
+	Allowed  []string
+}
+
+func (e *PreloadError) Error() string {
+	return fmt.Sprintf("cannot preload %q: %s", e.Relation, e.Reason)
+}
+
+func (e *PreloadError) Unwrap() error { return ErrInvalidPreload }
+
+// FieldsError reports a fields entry that is not a selectable column of the resource.
+type FieldsError struct {
+	Field   string
+	Allowed []string
+}
+
+func (e *FieldsError) Error() string {
+	return fmt.Sprintf("cannot select field %q, allowed: %s", e.Field, strings.Join(e.Allowed, ", "))
+}
+
+func (e *FieldsError) Unwrap() error { return ErrInvalidFields }
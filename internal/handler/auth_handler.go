@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"sort"
+	"strings"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	contextKeyUserID = "auth.user_id"
+	contextKeyRoles  = "auth.roles"
+)
+
+// PermissionGuard builds a middleware that requires the given permission type.
+type PermissionGuard func(permission string) gin.HandlerFunc
+
+type AuthHandler struct {
+	service       services.AuthService
+	authorization services.AuthorizationService
+}
+
+func NewAuthHandler(service services.AuthService, authorization services.AuthorizationService) *AuthHandler {
+	if service == nil {
+		return nil
+	}
+
+	return &AuthHandler{service: service, authorization: authorization}
+}
+
+func (h *AuthHandler) RegisterRoutes(group *gin.RouterGroup) {
+	auth := group.Group("/auth")
+	auth.POST("/login", h.Login)
+	auth.POST("/refresh", h.Refresh)
+	auth.POST("/logout", h.Logout)
+	auth.GET("/me", h.RequireAuth(), h.Me)
+}
+
+type loginRequest struct {
+	Identifier string `json:"identifier"`
+	Password   string `json:"password"`
+}
+
+type refreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	tokens, err := h.service.Login(c.Request.Context(), services.LoginInput{
+		Identifier: req.Identifier,
+		Password:   req.Password,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "login success", tokens)
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req refreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	tokens, err := h.service.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "token refreshed", tokens)
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req refreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	if err := h.service.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "logout success", nil)
+}
+
+func (h *AuthHandler) Me(c *gin.Context) {
+	userID, _ := CurrentUserID(c)
+
+	permissions := make([]string, 0)
+	if h.authorization != nil {
+		resolved, err := h.authorization.UserPermissions(c.Request.Context(), userID)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		permissions = resolved
+		sort.Strings(permissions)
+	}
+
+	response.OK(c, "current user", gin.H{
+		"user_id":     userID,
+		"roles":       CurrentRoles(c),
+		"permissions": permissions,
+	})
+}
+
+// RequireAuth validates the bearer token and stores the user ID and roles on the context.
+func (h *AuthHandler) RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := strings.TrimSpace(c.GetHeader("Authorization"))
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			response.Unauthorized(c, "missing bearer token", nil)
+			c.Abort()
+			return
+		}
+
+		claims, err := h.service.Authenticate(c.Request.Context(), token)
+		if err != nil {
+			respondError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(contextKeyUserID, claims.UserID)
+		c.Set(contextKeyRoles, claims.Roles)
+		c.Request = c.Request.WithContext(services.ContextWithActor(c.Request.Context(), claims.UserID))
+		c.Next()
+	}
+}
+
+// RequirePermission rejects requests whose user holds no role granting the permission.
+// It must run after RequireAuth.
+func (h *AuthHandler) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := CurrentUserID(c)
+		if !ok {
+			response.Unauthorized(c, "missing authenticated user", nil)
+			c.Abort()
+			return
+		}
+
+		allowed := false
+		if h.authorization != nil {
+			var err error
+			allowed, err = h.authorization.HasPermission(c.Request.Context(), userID, permission)
+			if err != nil {
+				respondError(c, err)
+				c.Abort()
+				return
+			}
+		}
+		if !allowed {
+			response.Forbidden(c, "insufficient permission", gin.H{"required_permission": permission})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CurrentUserID returns the authenticated user ID set by RequireAuth.
+func CurrentUserID(c *gin.Context) (int64, bool) {
+	value, ok := c.Get(contextKeyUserID)
+	if !ok {
+		return 0, false
+	}
+	userID, ok := value.(int64)
+	return userID, ok && userID > 0
+}
+
+// CurrentRoles returns the role names carried by the access token.
+func CurrentRoles(c *gin.Context) []string {
+	value, ok := c.Get(contextKeyRoles)
+	if !ok {
+		return nil
+	}
+	roles, _ := value.([]string)
+	return roles
+}
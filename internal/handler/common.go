@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/repository"
@@ -28,6 +29,8 @@ func parseIDParam(c *gin.Context, key string) (int64, error) {
 	return id, nil
 }
 
+// parseListRequest reads page/cursor paging, sort and search. Passing cursor (even empty, for the first
+// page) selects keyset paging, which cannot be combined with page.
 func parseListRequest(c *gin.Context) (repository.ListOptions, []string, error) {
 	page, err := parsePositiveIntQuery(c, "page", repository.DefaultPage)
 	if err != nil {
@@ -47,6 +50,20 @@ func parseListRequest(c *gin.Context) (repository.ListOptions, []string, error)
 		Search:    strings.TrimSpace(c.Query("search")),
 	}
 
+	if cursor, ok := c.GetQuery("cursor"); ok {
+		if strings.TrimSpace(c.Query("page")) != "" {
+			return repository.ListOptions{}, nil, errs.ErrInvalidPagination
+		}
+		opts.Cursor = strings.TrimSpace(cursor)
+	}
+	if value := strings.TrimSpace(c.Query("include_total")); value != "" {
+		includeTotal, err := strconv.ParseBool(value)
+		if err != nil {
+			return repository.ListOptions{}, nil, errs.ErrInvalidPagination
+		}
+		opts.IncludeTotal = includeTotal
+	}
+
 	preloads := parsePreloads(c.Query("preload"))
 	return opts, preloads, nil
 }
@@ -65,6 +82,21 @@ func parsePositiveIntQuery(c *gin.Context, key string, fallback int) (int, error
 	return parsed, nil
 }
 
+// parseDateQuery reads a YYYY-MM-DD query value; an empty value returns the zero time.
+func parseDateQuery(c *gin.Context, key string) (time.Time, error) {
+	value := strings.TrimSpace(c.Query(key))
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errs.ErrInvalidInput
+	}
+
+	return parsed, nil
+}
+
 func parsePreloads(value string) []string {
 	value = strings.TrimSpace(value)
 	if value == "" {
@@ -97,9 +129,23 @@ func paginationMeta(opts repository.ListOptions, total int64) response.Paginatio
 	return response.PaginationMeta{
 		Page:       opts.Page,
 		Limit:      opts.Limit,
-		TotalItems: total,
-		TotalPages: totalPages,
+		TotalItems: &total,
+		TotalPages: &totalPages,
+	}
+}
+
+func cursorPaginationMeta(opts repository.ListOptions, page *repository.CursorPage) response.PaginationMeta {
+	meta := response.PaginationMeta{
+		Limit:      opts.Limit,
+		NextCursor: page.NextCursor,
+		HasMore:    &page.HasMore,
+	}
+	if page.Total != nil {
+		meta.TotalItems = page.Total
+		totalPages := int(math.Ceil(float64(*page.Total) / float64(opts.Limit)))
+		meta.TotalPages = &totalPages
 	}
+	return meta
 }
 
 func respondError(c *gin.Context, err error) {
@@ -108,21 +154,65 @@ func respondError(c *gin.Context, err error) {
 		return
 	}
 
+	var rejected *errs.ApplicationRejectedError
+	var invalidFilter *errs.FilterError
+	var invalidSort *errs.SortError
+	var invalidPreload *errs.PreloadError
+	var invalidFields *errs.FieldsError
 	switch {
 	case errors.Is(err, gorm.ErrRecordNotFound):
 		response.NotFound(c, "data not found", err.Error())
+	case errors.As(err, &rejected):
+		response.UnprocessableEntity(c, err.Error(), gin.H{"customer_id": rejected.CustomerID, "reasons": rejected.Reasons})
+	case errors.As(err, &invalidFilter):
+		response.BadRequest(c, err.Error(), gin.H{"field": invalidFilter.Field, "allowed": invalidFilter.Allowed})
+	case errors.As(err, &invalidSort):
+		response.BadRequest(c, err.Error(), gin.H{"field": invalidSort.Field, "allowed": invalidSort.Allowed})
+	case errors.As(err, &invalidPreload):
+		response.BadRequest(c, err.Error(), gin.H{"relation": invalidPreload.Relation, "allowed": invalidPreload.Allowed})
+	case errors.As(err, &invalidFields):
+		response.BadRequest(c, err.Error(), gin.H{"field": invalidFields.Field, "allowed": invalidFields.Allowed})
 	case errors.Is(err, errs.ErrInvalidInput),
 		errors.Is(err, errs.ErrInvalidPagination),
 		errors.Is(err, errs.ErrInvalidSort),
 		errors.Is(err, errs.ErrInvalidSearch),
+		errors.Is(err, errs.ErrInvalidFilter),
+		errors.Is(err, errs.ErrInvalidCursor),
+		errors.Is(err, errs.ErrInvalidFields),
 		errors.Is(err, errs.ErrInvalidPassword),
 		errors.Is(err, errs.ErrInvalidDecision),
 		errors.Is(err, errs.ErrInvalidStatusTransition),
+		errors.Is(err, errs.ErrContractOutstanding),
+		errors.Is(err, errs.ErrNoPaymentSchedule),
+		errors.Is(err, errs.ErrContractOverdue),
+		errors.Is(err, errs.ErrContractNotOverdue),
 		errors.Is(err, errs.ErrContractNotDraft),
 		errors.Is(err, errs.ErrContractNotApproved),
 		errors.Is(err, errs.ErrDPOutOfRange),
-		errors.Is(err, errs.ErrInvalidPaymentAmount):
+		errors.Is(err, errs.ErrInvalidPaymentAmount),
+		errors.Is(err, errs.ErrPayoffUnderpaid),
+		errors.Is(err, errs.ErrPayoffQuoteExpired),
+		errors.Is(err, errs.ErrPayoffQuoteStale):
 		response.BadRequest(c, err.Error(), nil)
+	case errors.Is(err, errs.ErrInvalidCredentials),
+		errors.Is(err, errs.ErrInvalidToken):
+		response.Unauthorized(c, err.Error(), nil)
+	case errors.Is(err, errs.ErrAccountLocked),
+		errors.Is(err, errs.ErrAccountInactive),
+		errors.Is(err, errs.ErrTaskRoleMismatch),
+		errors.Is(err, errs.ErrSignedURLInvalid):
+		response.Forbidden(c, err.Error(), nil)
+	case errors.Is(err, errs.ErrFileMissing):
+		response.NotFound(c, err.Error(), nil)
+	case errors.Is(err, errs.ErrFileTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", err.Error(), nil)
+	case errors.Is(err, errs.ErrFileTypeNotAllowed):
+		response.Error(c, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", err.Error(), nil)
+	case errors.Is(err, errs.ErrPaymentScheduleLocked):
+		response.Conflict(c, err.Error(), nil)
+	case errors.Is(err, errs.ErrWorkflowStepNotFound),
+		errors.Is(err, errs.ErrInsuranceRateNotFound):
+		response.UnprocessableEntity(c, err.Error(), nil)
 	case errors.Is(err, errs.ErrInvalidEmail), isDuplicateKeyError(err):
 		response.Conflict(c, "duplicate data", err.Error())
 	default:
@@ -43,6 +43,26 @@ func (h *CRUDHandler[T]) List(c *gin.Context) {
 		respondError(c, err)
 		return
 	}
+	opts.Filters, err = h.mapper.parseFilters(c.Request.URL.Query())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	opts.Fields, err = h.mapper.parseFields(c.Query("fields"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	if _, cursorMode := c.GetQuery("cursor"); cursorMode {
+		items, page, err := h.service.ListCursor(c.Request.Context(), opts, preloads...)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		response.Paginated(c, fmt.Sprintf("%s list", h.name), h.mapper.projectResponse(items, opts.Fields, preloads), cursorPaginationMeta(opts, page))
+		return
+	}
 
 	items, total, err := h.service.List(c.Request.Context(), opts, preloads...)
 	if err != nil {
@@ -50,7 +70,7 @@ func (h *CRUDHandler[T]) List(c *gin.Context) {
 		return
 	}
 
-	response.Paginated(c, fmt.Sprintf("%s list", h.name), items, paginationMeta(opts, total))
+	response.Paginated(c, fmt.Sprintf("%s list", h.name), h.mapper.projectResponse(items, opts.Fields, preloads), paginationMeta(opts, total))
 }
 
 func (h *CRUDHandler[T]) GetByID(c *gin.Context) {
@@ -60,13 +80,20 @@ func (h *CRUDHandler[T]) GetByID(c *gin.Context) {
 		return
 	}
 
-	entity, err := h.service.GetByID(c.Request.Context(), id, parsePreloads(c.Query("preload"))...)
+	fields, err := h.mapper.parseFields(c.Query("fields"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	preloads := parsePreloads(c.Query("preload"))
+	entity, err := h.service.GetByIDFields(c.Request.Context(), id, fields, preloads...)
 	if err != nil {
 		respondError(c, err)
 		return
 	}
 
-	response.OK(c, fmt.Sprintf("%s detail", h.name), entity)
+	response.OK(c, fmt.Sprintf("%s detail", h.name), h.mapper.projectResponse(entity, fields, preloads))
 }
 
 func (h *CRUDHandler[T]) Create(c *gin.Context) {
@@ -87,7 +114,7 @@ func (h *CRUDHandler[T]) Create(c *gin.Context) {
 		return
 	}
 
-	response.Created(c, fmt.Sprintf("%s created", h.name), entity)
+	response.Created(c, fmt.Sprintf("%s created", h.name), h.mapper.projectResponse(&entity, nil, nil))
 }
 
 func (h *CRUDHandler[T]) Update(c *gin.Context) {
@@ -120,7 +147,7 @@ func (h *CRUDHandler[T]) Update(c *gin.Context) {
 		return
 	}
 
-	response.OK(c, fmt.Sprintf("%s updated", h.name), entity)
+	response.OK(c, fmt.Sprintf("%s updated", h.name), h.mapper.projectResponse(entity, nil, nil))
 }
 
 func (h *CRUDHandler[T]) Delete(c *gin.Context) {
@@ -152,14 +179,18 @@ func bindPayloadMap(c *gin.Context) (map[string]interface{}, error) {
 type modelPayloadMapper struct {
 	keyToFieldName map[string]string
 	keyToColumn    map[string]string
+	keyToType      map[string]reflect.Type
 	primaryColumns map[string]struct{}
+	secretColumns  map[string]struct{}
 }
 
 func newModelPayloadMapper[T any]() *modelPayloadMapper {
 	mapper := &modelPayloadMapper{
 		keyToFieldName: make(map[string]string),
 		keyToColumn:    make(map[string]string),
+		keyToType:      make(map[string]reflect.Type),
 		primaryColumns: make(map[string]struct{}),
+		secretColumns:  make(map[string]struct{}),
 	}
 
 	modelType := reflect.TypeOf((*T)(nil)).Elem()
@@ -170,6 +201,11 @@ func newModelPayloadMapper[T any]() *modelPayloadMapper {
 	if modelType.Kind() != reflect.Struct {
 		return mapper
 	}
+	if modelType.Implements(secretFielderType) {
+		for _, column := range reflect.Zero(modelType).Interface().(secretFielder).SecretFields() {
+			mapper.secretColumns[strings.ToLower(column)] = struct{}{}
+		}
+	}
 
 	for i := 0; i < modelType.NumField(); i++ {
 		field := modelType.Field(i)
@@ -180,8 +216,8 @@ func newModelPayloadMapper[T any]() *modelPayloadMapper {
 		fieldName := field.Name
 		column, primary := parseGormField(field.Tag.Get("gorm"), fieldName)
 
-		mapper.registerField(fieldName, fieldName, column)
-		mapper.registerField(toSnakeCase(fieldName), fieldName, column)
+		mapper.registerField(fieldName, fieldName, column, field.Type)
+		mapper.registerField(toSnakeCase(fieldName), fieldName, column, field.Type)
 
 		if primary {
 			mapper.primaryColumns[strings.ToLower(column)] = struct{}{}
@@ -191,7 +227,7 @@ func newModelPayloadMapper[T any]() *modelPayloadMapper {
 	return mapper
 }
 
-func (m *modelPayloadMapper) registerField(key, fieldName, column string) {
+func (m *modelPayloadMapper) registerField(key, fieldName, column string, fieldType reflect.Type) {
 	if key == "" {
 		return
 	}
@@ -207,6 +243,9 @@ func (m *modelPayloadMapper) registerField(key, fieldName, column string) {
 	if _, exists := m.keyToColumn[normalized]; !exists {
 		m.keyToColumn[normalized] = column
 	}
+	if _, exists := m.keyToType[normalized]; !exists {
+		m.keyToType[normalized] = fieldType
+	}
 }
 
 func (m *modelPayloadMapper) decodeCreatePayload(payload map[string]interface{}, out interface{}) error {
@@ -248,7 +287,11 @@ func (m *modelPayloadMapper) buildUpdatePayload(payload map[string]interface{})
 			continue
 		}
 
-		updates[column] = value
+		decoded, err := m.decodeUpdateValue(normalizedKey, value)
+		if err != nil {
+			return nil, err
+		}
+		updates[column] = decoded
 	}
 
 	if len(updates) == 0 {
@@ -258,6 +301,30 @@ func (m *modelPayloadMapper) buildUpdatePayload(payload map[string]interface{})
 	return updates, nil
 }
 
+// decodeUpdateValue runs values of custom JSON types (e.g. money.Amount) through their own decoder
+// so updates are stored exactly like creates instead of as raw float64.
+func (m *modelPayloadMapper) decodeUpdateValue(normalizedKey string, value interface{}) (interface{}, error) {
+	fieldType, ok := m.keyToType[normalizedKey]
+	if !ok || value == nil {
+		return value, nil
+	}
+	if !reflect.PointerTo(fieldType).Implements(jsonUnmarshalerType) {
+		return value, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, errs.ErrInvalidInput
+	}
+	target := reflect.New(fieldType)
+	if err := json.Unmarshal(encoded, target.Interface()); err != nil {
+		return nil, errs.ErrInvalidInput
+	}
+	return target.Elem().Interface(), nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
 func parseGormField(tag string, fallbackFieldName string) (column string, primary bool) {
 	column = toSnakeCase(fallbackFieldName)
 	parts := strings.Split(tag, ";")
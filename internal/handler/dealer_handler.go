@@ -6,17 +6,19 @@ import (
 )
 
 type DealerHandlers struct {
-	MotorType  ResourceHandler
-	Motor      ResourceHandler
-	MotorAsset ResourceHandler
-	Customer   ResourceHandler
+	MotorType         ResourceHandler
+	Motor             ResourceHandler
+	MotorAsset        ResourceHandler
+	Customer          ResourceHandler
+	CustomerBlacklist ResourceHandler
 }
 
 func NewDealerHandlers(s services.DealerServices) DealerHandlers {
 	return DealerHandlers{
-		MotorType:  NewCRUDHandler[models.MotorType]("motor type", s.MotorType),
-		Motor:      NewCRUDHandler[models.Motor]("motor", s.Motor),
-		MotorAsset: NewCRUDHandler[models.MotorAsset]("motor asset", s.MotorAsset),
-		Customer:   NewCRUDHandler[models.Customer]("customer", s.Customer),
+		MotorType:         NewCRUDHandler[models.MotorType]("motor type", s.MotorType),
+		Motor:             NewCRUDHandler[models.Motor]("motor", s.Motor),
+		MotorAsset:        NewCRUDHandler[models.MotorAsset]("motor asset", s.MotorAsset),
+		Customer:          NewCRUDHandler[models.Customer]("customer", s.Customer),
+		CustomerBlacklist: NewCRUDHandler[models.CustomerBlacklist]("customer blacklist", s.CustomerBlacklist),
 	}
 }
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+)
+
+// secretFielder is implemented by models holding hashes, secrets or tokens; the listed columns are
+// dropped from every CRUD response, including preloaded relations.
+type secretFielder interface {
+	SecretFields() []string
+}
+
+var (
+	secretFielderType = reflect.TypeOf((*secretFielder)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// parseFields turns ?fields=a,b into model columns. Only scalar, non-secret columns can be selected;
+// relations are requested with preload instead.
+func (m *modelPayloadMapper) parseFields(raw string) ([]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	seen := make(map[string]struct{})
+	fields := make([]string, 0, strings.Count(raw, ",")+1)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		column, ok := m.selectColumn(part)
+		if !ok {
+			return nil, &errs.FieldsError{Field: part, Allowed: m.selectFields()}
+		}
+		if _, dup := seen[column]; dup {
+			continue
+		}
+		seen[column] = struct{}{}
+		fields = append(fields, column)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+func (m *modelPayloadMapper) selectColumn(key string) (string, bool) {
+	normalized := normalizePayloadKey(key)
+	column, ok := m.keyToColumn[normalized]
+	if !ok || !isFilterableType(m.keyToType[normalized]) {
+		return "", false
+	}
+	if _, secret := m.secretColumns[strings.ToLower(column)]; secret {
+		return "", false
+	}
+	return column, true
+}
+
+// selectFields lists the selectable columns of the model, for error details.
+func (m *modelPayloadMapper) selectFields() []string {
+	seen := make(map[string]struct{}, len(m.keyToColumn))
+	fields := make([]string, 0, len(m.keyToColumn))
+	for key, column := range m.keyToColumn {
+		if _, ok := m.selectColumn(key); !ok {
+			continue
+		}
+		if _, exists := seen[column]; exists {
+			continue
+		}
+		seen[column] = struct{}{}
+		fields = append(fields, column)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// projectResponse prepares a model (or a slice of models) for the response. Secret columns are always
+// dropped; when fields were requested only those columns, the primary key and the preloaded relations of
+// the top-level model are kept, so unselected columns do not show up as zero values.
+func (m *modelPayloadMapper) projectResponse(value interface{}, fields []string, preloads []string) interface{} {
+	if len(fields) == 0 {
+		return projectValue(reflect.ValueOf(value), nil)
+	}
+
+	keep := make(map[string]struct{}, len(fields)+len(m.primaryColumns)+len(preloads))
+	for _, column := range fields {
+		keep[strings.ToLower(column)] = struct{}{}
+	}
+	for column := range m.primaryColumns {
+		keep[column] = struct{}{}
+	}
+	for _, preload := range preloads {
+		relation := strings.ToLower(strings.TrimSpace(strings.Split(preload, ".")[0]))
+		keep[relation] = struct{}{}
+	}
+	return projectValue(reflect.ValueOf(value), keep)
+}
+
+// responseField is one serialized struct field; column is the gorm column, or the lowercased field name
+// for relations.
+type responseField struct {
+	index  int
+	key    string
+	column string
+}
+
+var responseFieldCache sync.Map
+
+func responseFields(structType reflect.Type) []responseField {
+	if cached, ok := responseFieldCache.Load(structType); ok {
+		return cached.([]responseField)
+	}
+
+	secret := map[string]struct{}{}
+	if structType.Implements(secretFielderType) {
+		for _, column := range reflect.Zero(structType).Interface().(secretFielder).SecretFields() {
+			secret[strings.ToLower(column)] = struct{}{}
+		}
+	}
+
+	fields := make([]responseField, 0, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Name
+		if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name == "-" {
+			continue
+		} else if name != "" {
+			key = name
+		}
+
+		column, _ := parseGormField(field.Tag.Get("gorm"), field.Name)
+		if isRelationType(field.Type) {
+			column = field.Name
+		}
+		column = strings.ToLower(column)
+		if _, denied := secret[column]; denied {
+			continue
+		}
+		fields = append(fields, responseField{index: i, key: key, column: column})
+	}
+
+	responseFieldCache.Store(structType, fields)
+	return fields
+}
+
+// projectValue walks models, slices and pointers; keep filters the fields of the outermost model only.
+func projectValue(value reflect.Value, keep map[string]struct{}) interface{} {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return projectValue(value.Elem(), keep)
+	case reflect.Slice:
+		if value.IsNil() || !isRelationType(value.Type()) {
+			return value.Interface()
+		}
+		items := make([]interface{}, value.Len())
+		for i := range items {
+			items[i] = projectValue(value.Index(i), keep)
+		}
+		return items
+	case reflect.Struct:
+		if !isRelationType(value.Type()) {
+			return value.Interface()
+		}
+		fields := responseFields(value.Type())
+		object := make(jsonObject, 0, len(fields))
+		for _, field := range fields {
+			if keep != nil {
+				if _, ok := keep[field.column]; !ok {
+					continue
+				}
+			}
+			object = append(object, jsonField{key: field.key, value: projectValue(value.Field(field.index), nil)})
+		}
+		return object
+	}
+	return value.Interface()
+}
+
+// isRelationType reports model structs and collections of them; times and JSON-aware types are values.
+func isRelationType(fieldType reflect.Type) bool {
+	for fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType.Kind() == reflect.Struct &&
+		fieldType != timeType &&
+		!fieldType.Implements(jsonMarshalerType) &&
+		!reflect.PointerTo(fieldType).Implements(jsonMarshalerType)
+}
+
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// jsonObject marshals its fields in struct order, matching what encoding/json emits for the model.
+type jsonObject []jsonField
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
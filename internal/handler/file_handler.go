@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// multipartOverhead leaves room for form fields and boundaries on top of the file size limit.
+	multipartOverhead = 1 << 20
+	// multipartMemory is kept in memory while parsing; larger parts spill to temporary files.
+	multipartMemory = 8 << 20
+)
+
+// FileHandler serves multipart uploads and downloads of contract documents and motor assets.
+type FileHandler struct {
+	service services.FileService
+}
+
+func NewFileHandler(service services.FileService) *FileHandler {
+	return &FileHandler{service: service}
+}
+
+func (h *FileHandler) UploadContractDocument(c *gin.Context) {
+	h.upload(c, "contract_id", "contract document uploaded", func(ctx context.Context, input services.UploadInput) (interface{}, error) {
+		input.DocType = c.PostForm("doc_type")
+		return h.service.UploadContractDocument(ctx, input)
+	})
+}
+
+func (h *FileHandler) UploadMotorAsset(c *gin.Context) {
+	h.upload(c, "motor_id", "motor asset uploaded", func(ctx context.Context, input services.UploadInput) (interface{}, error) {
+		return h.service.UploadMotorAsset(ctx, input)
+	})
+}
+
+func (h *FileHandler) DownloadContractDocument(c *gin.Context) {
+	h.download(c, h.service.ContractDocumentFile)
+}
+
+func (h *FileHandler) DownloadMotorAsset(c *gin.Context) {
+	h.download(c, h.service.MotorAssetFile)
+}
+
+func (h *FileHandler) ContractDocumentSignedURL(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	signed, err := h.service.ContractDocumentSignedURL(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "signed url issued", signed)
+}
+
+// ServeSignedFile is the public target of signed URLs issued by the local and memory backends;
+// the expires and signature query parameters stand in for the bearer token.
+func (h *FileHandler) ServeSignedFile(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	file, err := h.service.SignedFile(c.Request.Context(), key, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	h.stream(c, file, "inline")
+}
+
+// upload reads the owner id form field and the "file" part; validation happens in the service.
+func (h *FileHandler) upload(c *gin.Context, ownerField, message string, store func(context.Context, services.UploadInput) (interface{}, error)) {
+	if limit := h.service.UploadLimit(); limit > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit+multipartOverhead)
+	}
+
+	if err := c.Request.ParseMultipartForm(multipartMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondError(c, errs.ErrFileTooLarge)
+			return
+		}
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	ownerID, err := strconv.ParseInt(strings.TrimSpace(c.PostForm(ownerField)), 10, 64)
+	if err != nil || ownerID < 1 {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	result, err := store(c.Request.Context(), services.UploadInput{
+		OwnerID:  ownerID,
+		FileName: header.Filename,
+		Content:  file,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.Created(c, message, result)
+}
+
+func (h *FileHandler) download(c *gin.Context, resolve func(context.Context, int64) (*services.StoredFile, error)) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	file, err := resolve(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	if file.RedirectURL != "" {
+		c.Header("Cache-Control", "no-store")
+		c.Redirect(http.StatusTemporaryRedirect, file.RedirectURL)
+		return
+	}
+	h.stream(c, file, "attachment")
+}
+
+func (h *FileHandler) stream(c *gin.Context, file *services.StoredFile, disposition string) {
+	defer file.Body.Close()
+
+	contentType := file.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	headers := map[string]string{
+		"Content-Disposition": mime.FormatMediaType(disposition, map[string]string{"filename": file.FileName}),
+	}
+	if file.Checksum != "" {
+		headers["X-Checksum-SHA256"] = file.Checksum
+	}
+	size := file.Size
+	if size <= 0 {
+		size = -1
+	}
+	c.DataFromReader(http.StatusOK, size, contentType, file.Body, headers)
+}
@@ -4,19 +4,23 @@ import "github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 
 // Handlers is a registry for all domain handlers.
 type Handlers struct {
+	Auth    *AuthHandler
 	Account AccountHandlers
 	MST     MSTHandlers
 	Dealer  DealerHandlers
 	Leasing LeasingHandlers
 	Payment PaymentHandlers
+	Files   *FileHandler
 }
 
 func NewHandlers(s *services.Services) *Handlers {
 	return &Handlers{
+		Auth:    NewAuthHandler(s.Auth, s.Authorization),
 		Account: NewAccountHandlers(s.Account),
 		MST:     NewMSTHandlers(s.MST),
 		Dealer:  NewDealerHandlers(s.Dealer),
 		Leasing: NewLeasingHandlers(s.Leasing),
 		Payment: NewPaymentHandlers(s.Payment),
+		Files:   NewFileHandler(s.Files),
 	}
 }
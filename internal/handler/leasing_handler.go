@@ -2,12 +2,15 @@ package handler
 
 import (
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/gin-gonic/gin"
 )
 
 type LeasingHandlers struct {
 	LeasingProduct          ResourceHandler
-	LeasingContract         ResourceHandler
+	InsuranceRate           ResourceHandler
+	LeasingContract         *LeasingContractHandler
 	LeasingTask             ResourceHandler
 	LeasingTaskAttribute    ResourceHandler
 	LeasingContractDocument ResourceHandler
@@ -17,10 +20,40 @@ type LeasingHandlers struct {
 func NewLeasingHandlers(s services.LeasingServices) LeasingHandlers {
 	return LeasingHandlers{
 		LeasingProduct:          NewCRUDHandler[models.LeasingProduct]("leasing product", s.LeasingProduct),
-		LeasingContract:         NewCRUDHandler[models.LeasingContract]("leasing contract", s.LeasingContract),
+		InsuranceRate:           NewCRUDHandler[models.InsuranceRate]("insurance rate", s.InsuranceRate),
+		LeasingContract:         NewLeasingContractHandler(s.LeasingContract),
 		LeasingTask:             NewCRUDHandler[models.LeasingTask]("leasing task", s.LeasingTask),
 		LeasingTaskAttribute:    NewCRUDHandler[models.LeasingTaskAttribute]("leasing task attribute", s.LeasingTaskAttribute),
 		LeasingContractDocument: NewCRUDHandler[models.LeasingContractDocument]("leasing contract document", s.LeasingContractDocument),
 		Workflow:                NewLeasingWorkflowHandler(s.Workflow),
 	}
 }
+
+// LeasingContractHandler adds contract-specific endpoints on top of the generic CRUD handler.
+type LeasingContractHandler struct {
+	*CRUDHandler[models.LeasingContract]
+	service services.LeasingContractService
+}
+
+func NewLeasingContractHandler(service services.LeasingContractService) *LeasingContractHandler {
+	return &LeasingContractHandler{
+		CRUDHandler: NewCRUDHandler[models.LeasingContract]("leasing contract", service),
+		service:     service,
+	}
+}
+
+func (h *LeasingContractHandler) History(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	history, err := h.service.ListStatusHistory(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "leasing contract status history", history)
+}
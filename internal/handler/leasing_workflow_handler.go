@@ -6,6 +6,7 @@ import (
 	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
 	"github.com/gin-gonic/gin"
 )
 
@@ -21,16 +22,22 @@ func NewLeasingWorkflowHandler(service services.LeasingWorkflowService) *Leasing
 	return &LeasingWorkflowHandler{service: service}
 }
 
-func (h *LeasingWorkflowHandler) RegisterRoutes(group *gin.RouterGroup) {
+func (h *LeasingWorkflowHandler) RegisterRoutes(group *gin.RouterGroup, require PermissionGuard) {
+	group.POST("/simulate", require(services.PermissionViewContract), h.Simulate)
+
 	workflow := group.Group("/workflow")
-	workflow.POST("/submit-application", h.SubmitApplication)
-	workflow.POST("/auto-scoring", h.ProcessAutoScoring)
-	workflow.POST("/survey", h.ProcessSurveyResult)
-	workflow.POST("/final-approval", h.ProcessFinalApproval)
-	workflow.POST("/akad", h.ExecuteAkad)
-	workflow.POST("/initial-payment", h.RecordInitialPayment)
-	workflow.POST("/dealer-fulfillment", h.ProcessDealerFulfillment)
-	workflow.POST("/delivery", h.CompleteDelivery)
+	workflow.POST("/submit-application", require(services.PermissionCreateContract), h.SubmitApplication)
+	workflow.POST("/auto-scoring", require(services.PermissionApproveContract), h.ProcessAutoScoring)
+	workflow.POST("/survey", require(services.PermissionCreateSurvey), h.ProcessSurveyResult)
+	workflow.POST("/final-approval", require(services.PermissionApproveContract), h.ProcessFinalApproval)
+	workflow.POST("/akad", require(services.PermissionCreateContract), h.ExecuteAkad)
+	workflow.POST("/initial-payment", require(services.PermissionRecordInitialPayment), h.RecordInitialPayment)
+	workflow.POST("/dealer-fulfillment", require(services.PermissionProcessPurchaseOrder), h.ProcessDealerFulfillment)
+	workflow.POST("/delivery", require(services.PermissionCreateContract), h.CompleteDelivery)
+	workflow.POST("/payoff-quote", require(services.PermissionViewPayment), h.PayoffQuote)
+	workflow.POST("/payoff", require(services.PermissionRecordPayment), h.SettlePayoff)
+	workflow.GET("/contract-states", require(services.PermissionViewContract), h.ContractStateGraph)
+	workflow.GET("/contract-states/:id", require(services.PermissionViewContract), h.ContractTransitions)
 }
 
 type contractDocumentRequest struct {
@@ -44,7 +51,7 @@ type submitApplicationRequest struct {
 	CustomerID  int64                     `json:"customer_id"`
 	MotorID     int64                     `json:"motor_id"`
 	ProductID   int64                     `json:"product_id"`
-	DPDibayar   float64                   `json:"dp_dibayar"`
+	DPDibayar   money.Amount              `json:"dp_dibayar"`
 	TenorBulan  int16                     `json:"tenor_bulan"`
 	RequestDate *time.Time                `json:"request_date"`
 	Documents   []contractDocumentRequest `json:"documents"`
@@ -52,6 +59,7 @@ type submitApplicationRequest struct {
 
 type autoScoringDecisionRequest struct {
 	ContractID        int64  `json:"contract_id"`
+	Auto              bool   `json:"auto"`
 	AutoApproved      bool   `json:"auto_approved"`
 	ManualReviewReady bool   `json:"manual_review_ready"`
 	ManualApproved    bool   `json:"manual_approved"`
@@ -59,10 +67,10 @@ type autoScoringDecisionRequest struct {
 }
 
 type surveyDecisionRequest struct {
-	ContractID   int64   `json:"contract_id"`
-	Decision     string  `json:"decision"`
-	AdditionalDP float64 `json:"additional_dp"`
-	Note         string  `json:"note"`
+	ContractID   int64        `json:"contract_id"`
+	Decision     string       `json:"decision"`
+	AdditionalDP money.Amount `json:"additional_dp"`
+	Note         string       `json:"note"`
 }
 
 type finalApprovalRequest struct {
@@ -80,12 +88,12 @@ type akadRequest struct {
 }
 
 type initialPaymentRequest struct {
-	ContractID       int64      `json:"contract_id"`
-	NomorBukti       string     `json:"nomor_bukti"`
-	JumlahBayar      float64    `json:"jumlah_bayar"`
-	TanggalBayar     *time.Time `json:"tanggal_bayar"`
-	MetodePembayaran string     `json:"metode_pembayaran"`
-	Provider         string     `json:"provider"`
+	ContractID       int64        `json:"contract_id"`
+	NomorBukti       string       `json:"nomor_bukti"`
+	JumlahBayar      money.Amount `json:"jumlah_bayar"`
+	TanggalBayar     *time.Time   `json:"tanggal_bayar"`
+	MetodePembayaran string       `json:"metode_pembayaran"`
+	Provider         string       `json:"provider"`
 }
 
 type dealerFulfillmentRequest struct {
@@ -105,6 +113,31 @@ type deliveryCompletionRequest struct {
 	ContractDocUploads []contractDocumentRequest `json:"contract_doc_uploads"`
 }
 
+type simulationRequest struct {
+	MotorID           int64        `json:"motor_id"`
+	HargaOTR          money.Amount `json:"harga_otr"`
+	ProductID         int64        `json:"product_id"`
+	DPDibayar         money.Amount `json:"dp_dibayar"`
+	TenorBulan        int16        `json:"tenor_bulan"`
+	TanggalMulaiCicil *time.Time   `json:"tanggal_mulai_cicil"`
+}
+
+type payoffQuoteRequest struct {
+	ContractID int64      `json:"contract_id"`
+	QuoteDate  *time.Time `json:"quote_date"`
+}
+
+type payoffRequest struct {
+	ContractID       int64        `json:"contract_id"`
+	QuoteID          int64        `json:"quote_id"`
+	NomorBukti       string       `json:"nomor_bukti"`
+	JumlahBayar      money.Amount `json:"jumlah_bayar"`
+	TanggalBayar     *time.Time   `json:"tanggal_bayar"`
+	MetodePembayaran string       `json:"metode_pembayaran"`
+	Provider         string       `json:"provider"`
+	Note             string       `json:"note"`
+}
+
 func (h *LeasingWorkflowHandler) SubmitApplication(c *gin.Context) {
 	var req submitApplicationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -112,7 +145,9 @@ func (h *LeasingWorkflowHandler) SubmitApplication(c *gin.Context) {
 		return
 	}
 
+	actorID, _ := CurrentUserID(c)
 	result, err := h.service.SubmitApplication(c.Request.Context(), services.SubmitApplicationInput{
+		ActorID:     actorID,
 		CustomerID:  req.CustomerID,
 		MotorID:     req.MotorID,
 		ProductID:   req.ProductID,
@@ -136,8 +171,11 @@ func (h *LeasingWorkflowHandler) ProcessAutoScoring(c *gin.Context) {
 		return
 	}
 
-	err := h.service.ProcessAutoScoring(c.Request.Context(), services.AutoScoringDecisionInput{
+	actorID, _ := CurrentUserID(c)
+	score, err := h.service.ProcessAutoScoring(c.Request.Context(), services.AutoScoringDecisionInput{
+		ActorID:           actorID,
 		ContractID:        req.ContractID,
+		Auto:              req.Auto,
 		AutoApproved:      req.AutoApproved,
 		ManualReviewReady: req.ManualReviewReady,
 		ManualApproved:    req.ManualApproved,
@@ -148,6 +186,10 @@ func (h *LeasingWorkflowHandler) ProcessAutoScoring(c *gin.Context) {
 		return
 	}
 
+	if score != nil {
+		response.OK(c, "auto scoring processed", score)
+		return
+	}
 	response.OK(c, "auto scoring processed", gin.H{"contract_id": req.ContractID})
 }
 
@@ -158,7 +200,9 @@ func (h *LeasingWorkflowHandler) ProcessSurveyResult(c *gin.Context) {
 		return
 	}
 
+	actorID, _ := CurrentUserID(c)
 	err := h.service.ProcessSurveyResult(c.Request.Context(), services.SurveyDecisionInput{
+		ActorID:      actorID,
 		ContractID:   req.ContractID,
 		Decision:     services.SurveyDecision(req.Decision),
 		AdditionalDP: req.AdditionalDP,
@@ -179,7 +223,9 @@ func (h *LeasingWorkflowHandler) ProcessFinalApproval(c *gin.Context) {
 		return
 	}
 
+	actorID, _ := CurrentUserID(c)
 	err := h.service.ProcessFinalApproval(c.Request.Context(), services.FinalApprovalInput{
+		ActorID:    actorID,
 		ContractID: req.ContractID,
 		Approved:   req.Approved,
 		Note:       req.Note,
@@ -204,7 +250,9 @@ func (h *LeasingWorkflowHandler) ExecuteAkad(c *gin.Context) {
 		akadDate = *req.AkadDate
 	}
 
+	actorID, _ := CurrentUserID(c)
 	err := h.service.ExecuteAkad(c.Request.Context(), services.AkadInput{
+		ActorID:              actorID,
 		ContractID:           req.ContractID,
 		ContractNumber:       req.ContractNumber,
 		AkadDate:             akadDate,
@@ -231,7 +279,9 @@ func (h *LeasingWorkflowHandler) RecordInitialPayment(c *gin.Context) {
 		tanggalBayar = *req.TanggalBayar
 	}
 
-	err := h.service.RecordInitialPayment(c.Request.Context(), services.InitialPaymentInput{
+	actorID, _ := CurrentUserID(c)
+	result, err := h.service.RecordInitialPayment(c.Request.Context(), services.InitialPaymentInput{
+		ActorID:          actorID,
 		ContractID:       req.ContractID,
 		NomorBukti:       req.NomorBukti,
 		JumlahBayar:      req.JumlahBayar,
@@ -244,7 +294,7 @@ func (h *LeasingWorkflowHandler) RecordInitialPayment(c *gin.Context) {
 		return
 	}
 
-	response.OK(c, "initial payment recorded", gin.H{"contract_id": req.ContractID})
+	response.OK(c, "initial payment recorded", result)
 }
 
 func (h *LeasingWorkflowHandler) ProcessDealerFulfillment(c *gin.Context) {
@@ -254,7 +304,9 @@ func (h *LeasingWorkflowHandler) ProcessDealerFulfillment(c *gin.Context) {
 		return
 	}
 
+	actorID, _ := CurrentUserID(c)
 	err := h.service.ProcessDealerFulfillment(c.Request.Context(), services.DealerFulfillmentInput{
+		ActorID:             actorID,
 		ContractID:          req.ContractID,
 		UnitReadyStock:      req.UnitReadyStock,
 		EstimatedIndentWeek: req.EstimatedIndentWeek,
@@ -280,7 +332,9 @@ func (h *LeasingWorkflowHandler) CompleteDelivery(c *gin.Context) {
 		deliveryDate = *req.DeliveryDate
 	}
 
+	actorID, _ := CurrentUserID(c)
 	err := h.service.CompleteDelivery(c.Request.Context(), services.DeliveryCompletionInput{
+		ActorID:            actorID,
 		ContractID:         req.ContractID,
 		DeliveryDate:       deliveryDate,
 		CustomerReceived:   req.CustomerReceived,
@@ -297,6 +351,104 @@ func (h *LeasingWorkflowHandler) CompleteDelivery(c *gin.Context) {
 	response.OK(c, "delivery completed", gin.H{"contract_id": req.ContractID})
 }
 
+func (h *LeasingWorkflowHandler) ContractStateGraph(c *gin.Context) {
+	response.OK(c, "contract state graph", h.service.ContractStateGraph())
+}
+
+func (h *LeasingWorkflowHandler) ContractTransitions(c *gin.Context) {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.service.ContractTransitions(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "contract transitions", result)
+}
+
+func (h *LeasingWorkflowHandler) Simulate(c *gin.Context) {
+	var req simulationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	result, err := h.service.Simulate(c.Request.Context(), services.SimulationInput{
+		MotorID:           req.MotorID,
+		HargaOTR:          req.HargaOTR,
+		ProductID:         req.ProductID,
+		DPDibayar:         req.DPDibayar,
+		TenorBulan:        req.TenorBulan,
+		TanggalMulaiCicil: req.TanggalMulaiCicil,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "installment simulation", result)
+}
+
+func (h *LeasingWorkflowHandler) PayoffQuote(c *gin.Context) {
+	var req payoffQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	quoteDate := time.Time{}
+	if req.QuoteDate != nil {
+		quoteDate = *req.QuoteDate
+	}
+
+	quote, err := h.service.PayoffQuote(c.Request.Context(), services.PayoffQuoteInput{
+		ContractID: req.ContractID,
+		QuoteDate:  quoteDate,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "payoff quote", quote)
+}
+
+func (h *LeasingWorkflowHandler) SettlePayoff(c *gin.Context) {
+	var req payoffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	tanggalBayar := time.Time{}
+	if req.TanggalBayar != nil {
+		tanggalBayar = *req.TanggalBayar
+	}
+	actorID, _ := CurrentUserID(c)
+	result, err := h.service.SettlePayoff(c.Request.Context(), services.PayoffInput{
+		ActorID:          actorID,
+		ContractID:       req.ContractID,
+		QuoteID:          req.QuoteID,
+		NomorBukti:       req.NomorBukti,
+		JumlahBayar:      req.JumlahBayar,
+		TanggalBayar:     tanggalBayar,
+		MetodePembayaran: req.MetodePembayaran,
+		Provider:         req.Provider,
+		Note:             req.Note,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "contract paid off", result)
+}
+
 func mapContractDocs(input []contractDocumentRequest) []services.ContractDocumentInput {
 	if len(input) == 0 {
 		return nil
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/repository"
+)
+
+// listQueryParams are read by parseListRequest and never treated as filters.
+var listQueryParams = map[string]struct{}{
+	"page":          {},
+	"limit":         {},
+	"sort_by":       {},
+	"sort_order":    {},
+	"search":        {},
+	"preload":       {},
+	"cursor":        {},
+	"include_total": {},
+	"fields":        {},
+}
+
+var filterKeyPattern = regexp.MustCompile(`^([A-Za-z0-9_]+)(?:\[([A-Za-z]+)\])?$`)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// parseFilters turns the list query parameters left after paging/sort/search into column filters,
+// e.g. status=approved, request_date[gte]=2026-01-01 or status[in]=draft,approved. Only scalar fields of
+// the model are accepted; repeated parameters are combined with AND.
+func (m *modelPayloadMapper) parseFilters(query url.Values) ([]repository.Filter, error) {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if _, reserved := listQueryParams[key]; !reserved {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	filters := make([]repository.Filter, 0, len(keys))
+	for _, key := range keys {
+		match := filterKeyPattern.FindStringSubmatch(key)
+		if match == nil {
+			return nil, m.filterError(key, "unknown filter field")
+		}
+		column, fieldType, ok := m.filterColumn(match[1])
+		if !ok {
+			return nil, m.filterError(key, "unknown filter field")
+		}
+
+		operator := strings.ToLower(match[2])
+		if operator == "" {
+			operator = repository.FilterEq
+		}
+
+		for _, raw := range query[key] {
+			value, err := parseFilterValue(operator, fieldType, strings.TrimSpace(raw))
+			if err != nil {
+				return nil, m.filterError(key, err.Error())
+			}
+			filters = append(filters, repository.Filter{Column: column, Operator: operator, Value: value})
+		}
+	}
+
+	return filters, nil
+}
+
+// filterColumn resolves a filter key (snake case, field name or column) to a filterable column.
+func (m *modelPayloadMapper) filterColumn(key string) (string, reflect.Type, bool) {
+	normalized := normalizePayloadKey(key)
+	column, ok := m.keyToColumn[normalized]
+	if !ok {
+		return "", nil, false
+	}
+	fieldType := m.keyToType[normalized]
+	if !isFilterableType(fieldType) {
+		return "", nil, false
+	}
+	// secret columns (SecretFields) cannot be probed through filters either
+	if _, secret := m.secretColumns[strings.ToLower(column)]; secret {
+		return "", nil, false
+	}
+	return column, fieldType, true
+}
+
+// filterFields lists the filterable columns of the model, for error details.
+func (m *modelPayloadMapper) filterFields() []string {
+	seen := make(map[string]struct{}, len(m.keyToColumn))
+	fields := make([]string, 0, len(m.keyToColumn))
+	for key, column := range m.keyToColumn {
+		if _, _, ok := m.filterColumn(key); !ok {
+			continue
+		}
+		if _, exists := seen[column]; exists {
+			continue
+		}
+		seen[column] = struct{}{}
+		fields = append(fields, column)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func (m *modelPayloadMapper) filterError(field, reason string) error {
+	return &errs.FilterError{Field: field, Reason: reason, Allowed: m.filterFields()}
+}
+
+func parseFilterValue(operator string, fieldType reflect.Type, raw string) (interface{}, error) {
+	baseType := fieldType
+	for baseType.Kind() == reflect.Pointer {
+		baseType = baseType.Elem()
+	}
+
+	switch operator {
+	case repository.FilterNull:
+		isNull, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s expects true or false", operator)
+		}
+		return isNull, nil
+	case repository.FilterLike:
+		if baseType.Kind() != reflect.String {
+			return nil, fmt.Errorf("%s only applies to text fields", operator)
+		}
+		if raw == "" {
+			return nil, fmt.Errorf("%s needs a value", operator)
+		}
+		return raw, nil
+	case repository.FilterIn:
+		parts := strings.Split(raw, ",")
+		values := make([]interface{}, 0, len(parts))
+		for _, part := range parts {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			value, err := convertFilterValue(baseType, part)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%s needs at least one value", operator)
+		}
+		return values, nil
+	case repository.FilterEq, repository.FilterNe:
+		return convertFilterValue(baseType, raw)
+	case repository.FilterGt, repository.FilterGte, repository.FilterLt, repository.FilterLte:
+		if baseType.Kind() == reflect.Bool {
+			return nil, fmt.Errorf("%s does not apply to true/false fields", operator)
+		}
+		return convertFilterValue(baseType, raw)
+	}
+
+	return nil, fmt.Errorf("unknown operator %q", operator)
+}
+
+// convertFilterValue parses raw into the field's Go type so it binds like the column; dates accept
+// YYYY-MM-DD or RFC 3339 and custom JSON types (money.Amount) go through their own decoder.
+func convertFilterValue(fieldType reflect.Type, raw string) (interface{}, error) {
+	invalid := fmt.Errorf("invalid value %q", raw)
+
+	if fieldType == timeType {
+		if parsed, err := time.Parse("2006-01-02", raw); err == nil {
+			return parsed, nil
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	}
+
+	if reflect.PointerTo(fieldType).Implements(jsonUnmarshalerType) {
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return nil, invalid
+		}
+		target := reflect.New(fieldType)
+		if err := json.Unmarshal(encoded, target.Interface()); err != nil {
+			return nil, invalid
+		}
+		return target.Elem().Interface(), nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return raw, nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, fieldType.Bits())
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(raw, 10, fieldType.Bits())
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(raw, fieldType.Bits())
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed, nil
+	}
+
+	return nil, invalid
+}
+
+// isFilterableType accepts scalar columns (text, numbers, booleans, dates) and pointers to them;
+// relations and collections are not filterable.
+func isFilterableType(fieldType reflect.Type) bool {
+	if fieldType == nil {
+		return false
+	}
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType == timeType {
+		return true
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -8,11 +8,15 @@ import (
 type PaymentHandlers struct {
 	PaymentSchedule ResourceHandler
 	Payment         ResourceHandler
+	Posting         *PaymentPostingHandler
+	Penalty         *PaymentPenaltyHandler
 }
 
 func NewPaymentHandlers(s services.PaymentServices) PaymentHandlers {
 	return PaymentHandlers{
 		PaymentSchedule: NewCRUDHandler[models.PaymentSchedule]("payment schedule", s.PaymentSchedule),
 		Payment:         NewCRUDHandler[models.Payment]("payment", s.Payment),
+		Posting:         NewPaymentPostingHandler(s.Posting),
+		Penalty:         NewPaymentPenaltyHandler(s.Penalty),
 	}
 }
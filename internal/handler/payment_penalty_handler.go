@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentPenaltyHandler struct {
+	service services.PenaltyService
+}
+
+func NewPaymentPenaltyHandler(service services.PenaltyService) *PaymentPenaltyHandler {
+	if service == nil {
+		return nil
+	}
+
+	return &PaymentPenaltyHandler{service: service}
+}
+
+// ContractPenalties returns the denda breakdown of a contract, as of ?as_of=YYYY-MM-DD (default today).
+func (h *PaymentPenaltyHandler) ContractPenalties(c *gin.Context) {
+	contractID, err := parseIDParam(c, "contract_id")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	asOf, err := parseDateQuery(c, "as_of")
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	breakdown, err := h.service.ContractPenalties(c.Request.Context(), contractID, asOf)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.OK(c, "contract penalty breakdown", breakdown)
+}
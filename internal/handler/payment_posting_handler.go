@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"time"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/response"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentPostingHandler struct {
+	service services.PaymentPostingService
+}
+
+func NewPaymentPostingHandler(service services.PaymentPostingService) *PaymentPostingHandler {
+	if service == nil {
+		return nil
+	}
+
+	return &PaymentPostingHandler{service: service}
+}
+
+type postPaymentRequest struct {
+	ContractID       int64        `json:"contract_id"`
+	NomorBukti       string       `json:"nomor_bukti"`
+	JumlahBayar      money.Amount `json:"jumlah_bayar"`
+	TanggalBayar     *time.Time   `json:"tanggal_bayar"`
+	MetodePembayaran string       `json:"metode_pembayaran"`
+	Provider         string       `json:"provider"`
+}
+
+func (h *PaymentPostingHandler) PostPayment(c *gin.Context) {
+	var req postPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, errs.ErrInvalidInput)
+		return
+	}
+
+	tanggalBayar := time.Time{}
+	if req.TanggalBayar != nil {
+		tanggalBayar = *req.TanggalBayar
+	}
+
+	actorID, _ := CurrentUserID(c)
+	result, err := h.service.PostPayment(c.Request.Context(), services.PostPaymentInput{
+		ActorID:          actorID,
+		ContractID:       req.ContractID,
+		NomorBukti:       req.NomorBukti,
+		JumlahBayar:      req.JumlahBayar,
+		TanggalBayar:     tanggalBayar,
+		MetodePembayaran: req.MetodePembayaran,
+		Provider:         req.Provider,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.Created(c, "payment posted", result)
+}
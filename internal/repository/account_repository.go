@@ -70,31 +70,55 @@ type rolePermissionRepository struct {
 }
 
 func NewOAuthProviderRepository(db *gorm.DB) OAuthProviderRepository {
-	return &oauthProviderRepository{baseRepository: newBaseRepository[models.OAuthProvider](db)}
+	return &oauthProviderRepository{baseRepository: newBaseRepository[models.OAuthProvider](db, listConfig{
+		search: []string{"provider_name", "client_id", "issuer_url"},
+		sort:   []string{"provider_id", "provider_name"},
+	})}
 }
 
 func NewUserRepository(db *gorm.DB) UserRepository {
-	return &userRepository{baseRepository: newBaseRepository[models.User](db)}
+	return &userRepository{baseRepository: newBaseRepository[models.User](db, listConfig{
+		search:  []string{"username", "full_name", "email", "phone_number"},
+		sort:    []string{"user_id", "username", "full_name", "email", "last_login", "created_at", "updated_at"},
+		preload: []string{"UserRoles", "UserRoles.Role"},
+	})}
 }
 
 func NewUserOAuthProviderRepository(db *gorm.DB) UserOAuthProviderRepository {
-	return &userOAuthProviderRepository{baseRepository: newBaseRepository[models.UserOAuthProvider](db)}
+	return &userOAuthProviderRepository{baseRepository: newBaseRepository[models.UserOAuthProvider](db, listConfig{
+		sort:    []string{"user_oauth_id", "user_id", "provider_id", "expires_at", "created_at"},
+		preload: []string{"Provider"},
+	})}
 }
 
 func NewRoleRepository(db *gorm.DB) RoleRepository {
-	return &roleRepository{baseRepository: newBaseRepository[models.Role](db)}
+	return &roleRepository{baseRepository: newBaseRepository[models.Role](db, listConfig{
+		search:  []string{"role_name", "description"},
+		sort:    []string{"role_id", "role_name"},
+		preload: []string{"RolePermissions", "RolePermissions.Permission"},
+	})}
 }
 
 func NewUserRoleRepository(db *gorm.DB) UserRoleRepository {
-	return &userRoleRepository{baseRepository: newBaseRepository[models.UserRole](db)}
+	return &userRoleRepository{baseRepository: newBaseRepository[models.UserRole](db, listConfig{
+		sort:    []string{"user_role_id", "user_id", "role_id", "assigned_at"},
+		preload: []string{"User", "Role"},
+	})}
 }
 
 func NewPermissionRepository(db *gorm.DB) PermissionRepository {
-	return &permissionRepository{baseRepository: newBaseRepository[models.Permission](db)}
+	return &permissionRepository{baseRepository: newBaseRepository[models.Permission](db, listConfig{
+		search:  []string{"permission_type", "description"},
+		sort:    []string{"permission_id", "permission_type"},
+		preload: []string{"RolePermissions", "RolePermissions.Role"},
+	})}
 }
 
 func NewRolePermissionRepository(db *gorm.DB) RolePermissionRepository {
-	return &rolePermissionRepository{baseRepository: newBaseRepository[models.RolePermission](db)}
+	return &rolePermissionRepository{baseRepository: newBaseRepository[models.RolePermission](db, listConfig{
+		sort:    []string{"role_permission_id", "role_id", "permission_id"},
+		preload: []string{"Role", "Permission"},
+	})}
 }
 
 func (r *oauthProviderRepository) GetByProviderName(ctx context.Context, providerName string) (*models.OAuthProvider, error) {
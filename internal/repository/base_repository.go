@@ -12,29 +12,41 @@ import (
 type CRUDRepository[T any] interface {
 	Create(ctx context.Context, entity *T) error
 	GetByID(ctx context.Context, id int64, preloads ...string) (*T, error)
+	GetByIDFields(ctx context.Context, id int64, fields []string, preloads ...string) (*T, error)
 	FindOne(ctx context.Context, condition interface{}, args ...interface{}) (*T, error)
 	List(ctx context.Context, opts ListOptions, preloads ...string) ([]T, int64, error)
+	ListCursor(ctx context.Context, opts ListOptions, preloads ...string) ([]T, *CursorPage, error)
 	Update(ctx context.Context, id int64, updates map[string]interface{}) error
 	Delete(ctx context.Context, id int64) error
 }
 
+// listConfig declares the columns list requests may search (ILIKE, text columns only) and sort on, and the
+// relations list and detail requests may preload (at most MaxPreloadDepth levels).
+type listConfig struct {
+	search  []string
+	sort    []string
+	preload []string
+}
+
 type baseRepository[T any] struct {
-	db *gorm.DB
+	db   *gorm.DB
+	list listConfig
 }
 
-func newBaseRepository[T any](db *gorm.DB) *baseRepository[T] {
-	return &baseRepository[T]{db: db}
+func newBaseRepository[T any](db *gorm.DB, list listConfig) *baseRepository[T] {
+	return &baseRepository[T]{db: db, list: list}
 }
 
-func (r *baseRepository[T]) withPreloads(query *gorm.DB, preloads []string) *gorm.DB {
-	for _, preload := range preloads {
-		preload = strings.TrimSpace(preload)
-		if preload == "" {
-			continue
-		}
+// withPreloads applies the requested relations after checking them against the repository's listConfig.
+func (r *baseRepository[T]) withPreloads(query *gorm.DB, preloads []string) (*gorm.DB, error) {
+	resolved, err := resolvePreloads(r.list.preload, preloads)
+	if err != nil {
+		return nil, err
+	}
+	for _, preload := range resolved {
 		query = query.Preload(preload)
 	}
-	return query
+	return query, nil
 }
 
 func (r *baseRepository[T]) Create(ctx context.Context, entity *T) error {
@@ -45,12 +57,25 @@ func (r *baseRepository[T]) Create(ctx context.Context, entity *T) error {
 }
 
 func (r *baseRepository[T]) GetByID(ctx context.Context, id int64, preloads ...string) (*T, error) {
+	return r.GetByIDFields(ctx, id, nil, preloads...)
+}
+
+// GetByIDFields loads only the given columns (plus the keys withFields always keeps); nil loads the whole row.
+func (r *baseRepository[T]) GetByIDFields(ctx context.Context, id int64, fields []string, preloads ...string) (*T, error) {
 	if id < 1 {
 		return nil, errs.ErrInvalidInput
 	}
 
+	query, err := r.withPreloads(r.db.WithContext(ctx), preloads)
+	if err != nil {
+		return nil, err
+	}
+	query, err = r.withFields(query, fields, preloads)
+	if err != nil {
+		return nil, err
+	}
+
 	result := new(T)
-	query := r.withPreloads(r.db.WithContext(ctx), preloads)
 	if err := query.First(result, id).Error; err != nil {
 		return nil, err
 	}
@@ -66,8 +91,19 @@ func (r *baseRepository[T]) FindOne(ctx context.Context, condition interface{},
 	return result, nil
 }
 
+func (r *baseRepository[T]) withListConfig(opts ListOptions) ListOptions {
+	if len(opts.SearchFields) == 0 {
+		opts.SearchFields = r.list.search
+	}
+	if len(opts.AllowedSortFields) == 0 {
+		opts.AllowedSortFields = r.list.sort
+	}
+	return opts
+}
+
+// List searches and sorts on the repository's listConfig unless opts names its own fields.
 func (r *baseRepository[T]) List(ctx context.Context, opts ListOptions, preloads ...string) ([]T, int64, error) {
-	normalized, err := normalizeListOptions(opts)
+	normalized, err := normalizeListOptions(r.withListConfig(opts))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -77,13 +113,26 @@ func (r *baseRepository[T]) List(ctx context.Context, opts ListOptions, preloads
 	if err != nil {
 		return nil, 0, err
 	}
+	if _, err := resolvePreloads(r.list.preload, preloads); err != nil {
+		return nil, 0, err
+	}
+	if _, err := r.withFields(query, normalized.Fields, preloads); err != nil {
+		return nil, 0, err
+	}
 
 	var total int64
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	query = r.withPreloads(query, preloads)
+	query, err = r.withPreloads(query, preloads)
+	if err != nil {
+		return nil, 0, err
+	}
+	query, err = r.withFields(query, normalized.Fields, preloads)
+	if err != nil {
+		return nil, 0, err
+	}
 	offset := (normalized.Page - 1) * normalized.Limit
 	query = query.Offset(offset).Limit(normalized.Limit)
 
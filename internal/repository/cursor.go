@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// CursorPage describes a keyset page. NextCursor is empty on the last page; Total is only counted when
+// ListOptions.IncludeTotal is set.
+type CursorPage struct {
+	NextCursor string
+	HasMore    bool
+	Total      *int64
+}
+
+// listCursor is the opaque cursor: the sort column and order it was issued for, plus the sort value and
+// primary key of the last row returned.
+type listCursor struct {
+	SortBy string          `json:"s"`
+	Order  string          `json:"o"`
+	Value  json.RawMessage `json:"v,omitempty"`
+	Key    json.RawMessage `json:"k"`
+}
+
+// ListCursor pages by (sort column, primary key) instead of OFFSET, so deep pages cost the same as the
+// first one. Sorting on a nullable column is rejected because NULLs cannot be compared in a keyset.
+func (r *baseRepository[T]) ListCursor(ctx context.Context, opts ListOptions, preloads ...string) ([]T, *CursorPage, error) {
+	normalized, err := normalizeListOptions(r.withListConfig(opts))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sch, err := r.schema()
+	if err != nil {
+		return nil, nil, err
+	}
+	primary := sch.PrioritizedPrimaryField
+	if primary == nil {
+		return nil, nil, errs.ErrInvalidCursor
+	}
+
+	sortField := primary
+	if normalized.SortBy != "" {
+		sortField = sch.LookUpField(normalized.SortBy)
+		if sortField == nil || sortField.FieldType.Kind() == reflect.Pointer {
+			return nil, nil, &errs.SortError{Field: normalized.SortBy, Allowed: keysetSortFields(sch, normalized.AllowedSortFields)}
+		}
+	}
+	order := normalized.SortOrder
+	if order == "" {
+		order = "ASC"
+	}
+
+	if _, err := resolvePreloads(r.list.preload, preloads); err != nil {
+		return nil, nil, err
+	}
+	if _, err := r.withFields(r.db, normalized.Fields, preloads); err != nil {
+		return nil, nil, err
+	}
+
+	filtered := normalized
+	filtered.SortBy = ""
+	query, err := applySearchAndSort(r.db.WithContext(ctx).Model(new(T)), filtered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &CursorPage{}
+	if opts.IncludeTotal {
+		var total int64
+		if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			return nil, nil, err
+		}
+		page.Total = &total
+	}
+
+	if opts.Cursor != "" {
+		cursor, err := decodeCursor(opts.Cursor)
+		if err != nil || cursor.SortBy != sortField.DBName || cursor.Order != order {
+			return nil, nil, errs.ErrInvalidCursor
+		}
+		key, err := cursorValue(primary, cursor.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		comparison := ">"
+		if order == "DESC" {
+			comparison = "<"
+		}
+		if sortField == primary {
+			query = query.Where(fmt.Sprintf("%s %s ?", primary.DBName, comparison), key)
+		} else {
+			value, err := cursorValue(sortField, cursor.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			query = query.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortField.DBName, primary.DBName, comparison), value, key)
+		}
+	}
+
+	if sortField != primary {
+		query = query.Order(fmt.Sprintf("%s %s", sortField.DBName, order))
+	}
+	query = query.Order(fmt.Sprintf("%s %s", primary.DBName, order))
+	query, err = r.withPreloads(query, preloads)
+	if err != nil {
+		return nil, nil, err
+	}
+	query, err = r.withFields(query, normalized.Fields, preloads, sortField.DBName)
+	if err != nil {
+		return nil, nil, err
+	}
+	query = query.Limit(normalized.Limit + 1)
+
+	var items []T
+	if err := query.Find(&items).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(items) <= normalized.Limit {
+		return items, page, nil
+	}
+
+	items = items[:normalized.Limit]
+	last := reflect.ValueOf(&items[len(items)-1]).Elem()
+	next := listCursor{SortBy: sortField.DBName, Order: order}
+	if next.Key, err = fieldJSON(ctx, primary, last); err != nil {
+		return nil, nil, err
+	}
+	if sortField != primary {
+		if next.Value, err = fieldJSON(ctx, sortField, last); err != nil {
+			return nil, nil, err
+		}
+	}
+	if page.NextCursor, err = encodeCursor(next); err != nil {
+		return nil, nil, err
+	}
+	page.HasMore = true
+	return items, page, nil
+}
+
+func encodeCursor(cursor listCursor) (string, error) {
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+func decodeCursor(raw string) (*listCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	var cursor listCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil || len(cursor.Key) == 0 {
+		return nil, errs.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func fieldJSON(ctx context.Context, field *schema.Field, row reflect.Value) (json.RawMessage, error) {
+	value, _ := field.ValueOf(ctx, row)
+	return json.Marshal(value)
+}
+
+// cursorValue decodes a cursor value back into the field's Go type so it binds like the column.
+func cursorValue(field *schema.Field, raw json.RawMessage) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, errs.ErrInvalidCursor
+	}
+	target := reflect.New(field.FieldType)
+	if err := json.Unmarshal(raw, target.Interface()); err != nil {
+		return nil, errs.ErrInvalidCursor
+	}
+	return target.Elem().Interface(), nil
+}
+
+// keysetSortFields keeps the sortable columns that are NOT NULL in the model.
+func keysetSortFields(sch *schema.Schema, allowed []string) []string {
+	fields := make([]string, 0, len(allowed))
+	for _, column := range allowed {
+		if field := sch.LookUpField(column); field != nil && field.FieldType.Kind() != reflect.Pointer {
+			fields = append(fields, column)
+		}
+	}
+	return fields
+}
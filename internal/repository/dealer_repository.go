@@ -31,6 +31,11 @@ type CustomerRepository interface {
 	GetByEmail(ctx context.Context, email string) (*models.Customer, error)
 }
 
+type CustomerBlacklistRepository interface {
+	CRUDRepository[models.CustomerBlacklist]
+	ListActiveByNIK(ctx context.Context, nik string) ([]models.CustomerBlacklist, error)
+}
+
 type motorTypeRepository struct {
 	*baseRepository[models.MotorType]
 }
@@ -47,20 +52,39 @@ type customerRepository struct {
 	*baseRepository[models.Customer]
 }
 
+type customerBlacklistRepository struct {
+	*baseRepository[models.CustomerBlacklist]
+}
+
 func NewMotorTypeRepository(db *gorm.DB) MotorTypeRepository {
-	return &motorTypeRepository{baseRepository: newBaseRepository[models.MotorType](db)}
+	return &motorTypeRepository{baseRepository: newBaseRepository[models.MotorType](db, listConfig{
+		search: []string{"moty_name"},
+		sort:   []string{"moty_id", "moty_name"},
+	})}
 }
 
 func NewMotorRepository(db *gorm.DB) MotorRepository {
-	return &motorRepository{baseRepository: newBaseRepository[models.Motor](db)}
+	return &motorRepository{baseRepository: newBaseRepository[models.Motor](db, listConfig{
+		search:  []string{"merk", "motor_type", "warna", "nomor_rangka", "nomor_mesin", "nomor_polisi"},
+		sort:    []string{"motor_id", "merk", "motor_type", "tahun", "nomor_polisi", "status_unit", "harga_otr", "created_at"},
+		preload: []string{"MotorTypeRef", "MotorAssets"},
+	})}
 }
 
 func NewMotorAssetRepository(db *gorm.DB) MotorAssetRepository {
-	return &motorAssetRepository{baseRepository: newBaseRepository[models.MotorAsset](db)}
+	return &motorAssetRepository{baseRepository: newBaseRepository[models.MotorAsset](db, listConfig{
+		search:  []string{"file_name"},
+		sort:    []string{"moas_id", "file_name", "file_size", "moas_motor_id"},
+		preload: []string{"Motor", "Motor.MotorTypeRef"},
+	})}
 }
 
 func NewCustomerRepository(db *gorm.DB) CustomerRepository {
-	return &customerRepository{baseRepository: newBaseRepository[models.Customer](db)}
+	return &customerRepository{baseRepository: newBaseRepository[models.Customer](db, listConfig{
+		search:  []string{"nik", "nama_lengkap", "no_hp", "email"},
+		sort:    []string{"customer_id", "nik", "nama_lengkap", "tanggal_lahir", "salary", "created_at", "updated_at"},
+		preload: []string{"Location", "Location.Kelurahan", "LeasingContracts"},
+	})}
 }
 
 func (r *motorTypeRepository) GetByName(ctx context.Context, name string) (*models.MotorType, error) {
@@ -119,3 +143,28 @@ func (r *customerRepository) GetByEmail(ctx context.Context, email string) (*mod
 
 	return r.FindOne(ctx, "email = ?", value)
 }
+
+func NewCustomerBlacklistRepository(db *gorm.DB) CustomerBlacklistRepository {
+	return &customerBlacklistRepository{baseRepository: newBaseRepository[models.CustomerBlacklist](db, listConfig{
+		search:  []string{"nik", "reason"},
+		sort:    []string{"blacklist_id", "nik", "expires_at", "created_at"},
+		preload: []string{"Customer"},
+	})}
+}
+
+// ListActiveByNIK returns entries for the NIK that have not expired.
+func (r *customerBlacklistRepository) ListActiveByNIK(ctx context.Context, nik string) ([]models.CustomerBlacklist, error) {
+	value, err := validateLookupValue(nik)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []models.CustomerBlacklist
+	if err := r.db.WithContext(ctx).
+		Where("nik = ? AND (expires_at IS NULL OR expires_at > NOW())", value).
+		Order("created_at DESC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
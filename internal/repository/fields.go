@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"strings"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// schema returns the parsed GORM schema of T (cached by GORM after the first parse).
+func (r *baseRepository[T]) schema() (*schema.Schema, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, err
+	}
+	return stmt.Schema, nil
+}
+
+// withFields narrows the SELECT to the requested columns. The primary key, the keys that join the
+// requested preloads and any extra column the caller orders by are always selected so relations and
+// cursors keep working.
+func (r *baseRepository[T]) withFields(query *gorm.DB, fields []string, preloads []string, extra ...string) (*gorm.DB, error) {
+	if len(fields) == 0 {
+		return query, nil
+	}
+
+	sch, err := r.schema()
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := resolvePreloads(r.list.preload, preloads)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(fields)+len(sch.PrimaryFieldDBNames)+len(resolved)+len(extra))
+	seen := make(map[string]struct{}, cap(columns))
+	add := func(column string) {
+		if _, dup := seen[column]; dup || column == "" {
+			return
+		}
+		seen[column] = struct{}{}
+		columns = append(columns, column)
+	}
+
+	for _, column := range sch.PrimaryFieldDBNames {
+		add(column)
+	}
+	for _, field := range fields {
+		column := strings.TrimSpace(field)
+		if found, ok := sch.FieldsByDBName[column]; !ok || found.DBName != column {
+			return nil, &errs.FieldsError{Field: field, Allowed: sch.DBNames}
+		}
+		add(column)
+	}
+	for _, preload := range resolved {
+		relation, ok := sch.Relationships.Relations[strings.Split(preload, ".")[0]]
+		if !ok {
+			continue
+		}
+		for _, ref := range relation.References {
+			if ref.ForeignKey != nil && ref.ForeignKey.Schema == sch {
+				add(ref.ForeignKey.DBName)
+			}
+			if ref.PrimaryKey != nil && ref.PrimaryKey.Schema == sch {
+				add(ref.PrimaryKey.DBName)
+			}
+		}
+	}
+	for _, column := range extra {
+		add(column)
+	}
+
+	return query.Select(columns), nil
+}
@@ -14,6 +14,11 @@ type LeasingProductRepository interface {
 	GetByKodeProduk(ctx context.Context, kodeProduk string) (*models.LeasingProduct, error)
 }
 
+type InsuranceRateRepository interface {
+	CRUDRepository[models.InsuranceRate]
+	ListByMotorTypeID(ctx context.Context, motyID int64) ([]models.InsuranceRate, error)
+}
+
 type LeasingContractRepository interface {
 	CRUDRepository[models.LeasingContract]
 	GetByContractNumber(ctx context.Context, contractNumber string) (*models.LeasingContract, error)
@@ -39,6 +44,10 @@ type leasingProductRepository struct {
 	*baseRepository[models.LeasingProduct]
 }
 
+type insuranceRateRepository struct {
+	*baseRepository[models.InsuranceRate]
+}
+
 type leasingContractRepository struct {
 	*baseRepository[models.LeasingContract]
 }
@@ -56,23 +65,49 @@ type leasingContractDocumentRepository struct {
 }
 
 func NewLeasingProductRepository(db *gorm.DB) LeasingProductRepository {
-	return &leasingProductRepository{baseRepository: newBaseRepository[models.LeasingProduct](db)}
+	return &leasingProductRepository{baseRepository: newBaseRepository[models.LeasingProduct](db, listConfig{
+		search: []string{"kode_produk", "nama_produk"},
+		sort:   []string{"product_id", "kode_produk", "nama_produk", "tenor_bulan", "bunga_flat", "created_at"},
+	})}
+}
+
+func NewInsuranceRateRepository(db *gorm.DB) InsuranceRateRepository {
+	return &insuranceRateRepository{baseRepository: newBaseRepository[models.InsuranceRate](db, listConfig{
+		sort:    []string{"rate_id", "tenor_bulan", "rate_persen", "moty_id", "created_at"},
+		preload: []string{"MotorType"},
+	})}
 }
 
 func NewLeasingContractRepository(db *gorm.DB) LeasingContractRepository {
-	return &leasingContractRepository{baseRepository: newBaseRepository[models.LeasingContract](db)}
+	return &leasingContractRepository{baseRepository: newBaseRepository[models.LeasingContract](db, listConfig{
+		search:  []string{"contract_number", "status"},
+		sort:    []string{"contract_id", "contract_number", "request_date", "tanggal_akad", "tenor_bulan", "nilai_kendaraan", "pokok_pinjaman", "status", "created_at", "updated_at"},
+		preload: []string{"Customer", "Customer.Location", "Motor", "Motor.MotorTypeRef", "Product", "LeasingTasks", "PaymentSchedules", "Payments", "ContractDocuments", "StatusHistory"},
+	})}
 }
 
 func NewLeasingTaskRepository(db *gorm.DB) LeasingTaskRepository {
-	return &leasingTaskRepository{baseRepository: newBaseRepository[models.LeasingTask](db)}
+	return &leasingTaskRepository{baseRepository: newBaseRepository[models.LeasingTask](db, listConfig{
+		search:  []string{"task_name", "task_code", "status"},
+		sort:    []string{"task_id", "sequence_no", "startdate", "enddate", "status", "contract_id"},
+		preload: []string{"Contract", "Role", "LeasingAttribute"},
+	})}
 }
 
 func NewLeasingTaskAttributeRepository(db *gorm.DB) LeasingTaskAttributeRepository {
-	return &leasingTaskAttributeRepository{baseRepository: newBaseRepository[models.LeasingTaskAttribute](db)}
+	return &leasingTaskAttributeRepository{baseRepository: newBaseRepository[models.LeasingTaskAttribute](db, listConfig{
+		search:  []string{"tasa_name", "tasa_value"},
+		sort:    []string{"tasa_id", "tasa_name", "tasa_status", "tasa_leta_id"},
+		preload: []string{"Task"},
+	})}
 }
 
 func NewLeasingContractDocumentRepository(db *gorm.DB) LeasingContractDocumentRepository {
-	return &leasingContractDocumentRepository{baseRepository: newBaseRepository[models.LeasingContractDocument](db)}
+	return &leasingContractDocumentRepository{baseRepository: newBaseRepository[models.LeasingContractDocument](db, listConfig{
+		search:  []string{"file_name"},
+		sort:    []string{"loc_id", "file_name", "file_size", "doc_type", "contract_id"},
+		preload: []string{"Contract"},
+	})}
 }
 
 func (r *leasingProductRepository) GetByKodeProduk(ctx context.Context, kodeProduk string) (*models.LeasingProduct, error) {
@@ -84,6 +119,18 @@ func (r *leasingProductRepository) GetByKodeProduk(ctx context.Context, kodeProd
 	return r.FindOne(ctx, "kode_produk = ?", value)
 }
 
+func (r *insuranceRateRepository) ListByMotorTypeID(ctx context.Context, motyID int64) ([]models.InsuranceRate, error) {
+	if motyID < 1 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var items []models.InsuranceRate
+	if err := r.db.WithContext(ctx).Where("moty_id = ?", motyID).Order("tenor_bulan ASC").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 func (r *leasingContractRepository) GetByContractNumber(ctx context.Context, contractNumber string) (*models.LeasingContract, error) {
 	value, err := validateLookupValue(contractNumber)
 	if err != nil {
@@ -73,31 +73,59 @@ type templateTaskAttributeRepository struct {
 }
 
 func NewProvinceRepository(db *gorm.DB) ProvinceRepository {
-	return &provinceRepository{baseRepository: newBaseRepository[models.Province](db)}
+	return &provinceRepository{baseRepository: newBaseRepository[models.Province](db, listConfig{
+		search:  []string{"prov_name"},
+		sort:    []string{"prov_id", "prov_name"},
+		preload: []string{"Kabupaten"},
+	})}
 }
 
 func NewKabupatenRepository(db *gorm.DB) KabupatenRepository {
-	return &kabupatenRepository{baseRepository: newBaseRepository[models.Kabupaten](db)}
+	return &kabupatenRepository{baseRepository: newBaseRepository[models.Kabupaten](db, listConfig{
+		search:  []string{"kab_name"},
+		sort:    []string{"kab_id", "kab_name", "prov_id"},
+		preload: []string{"Province", "Kecamatan"},
+	})}
 }
 
 func NewKecamatanRepository(db *gorm.DB) KecamatanRepository {
-	return &kecamatanRepository{baseRepository: newBaseRepository[models.Kecamatan](db)}
+	return &kecamatanRepository{baseRepository: newBaseRepository[models.Kecamatan](db, listConfig{
+		search:  []string{"kec_name"},
+		sort:    []string{"kec_id", "kec_name", "kab_id"},
+		preload: []string{"Kabupaten", "Kabupaten.Province", "Kelurahan"},
+	})}
 }
 
 func NewKelurahanRepository(db *gorm.DB) KelurahanRepository {
-	return &kelurahanRepository{baseRepository: newBaseRepository[models.Kelurahan](db)}
+	return &kelurahanRepository{baseRepository: newBaseRepository[models.Kelurahan](db, listConfig{
+		search:  []string{"kel_name"},
+		sort:    []string{"kel_id", "kel_name", "kec_id"},
+		preload: []string{"Kecamatan", "Kecamatan.Kabupaten", "Locations"},
+	})}
 }
 
 func NewLocationRepository(db *gorm.DB) LocationRepository {
-	return &locationRepository{baseRepository: newBaseRepository[models.Location](db)}
+	return &locationRepository{baseRepository: newBaseRepository[models.Location](db, listConfig{
+		search:  []string{"street_address", "postal_code"},
+		sort:    []string{"location_id", "postal_code", "kel_id"},
+		preload: []string{"Kelurahan", "Kelurahan.Kecamatan"},
+	})}
 }
 
 func NewTemplateTaskRepository(db *gorm.DB) TemplateTaskRepository {
-	return &templateTaskRepository{baseRepository: newBaseRepository[models.TemplateTask](db)}
+	return &templateTaskRepository{baseRepository: newBaseRepository[models.TemplateTask](db, listConfig{
+		search:  []string{"teta_name", "teta_code"},
+		sort:    []string{"teta_id", "teta_name", "teta_code"},
+		preload: []string{"Role", "Attributes"},
+	})}
 }
 
 func NewTemplateTaskAttributeRepository(db *gorm.DB) TemplateTaskAttributeRepository {
-	return &templateTaskAttributeRepository{baseRepository: newBaseRepository[models.TemplateTaskAttribute](db)}
+	return &templateTaskAttributeRepository{baseRepository: newBaseRepository[models.TemplateTaskAttribute](db, listConfig{
+		search:  []string{"tetat_name"},
+		sort:    []string{"tetat_id", "tetat_name", "tetat_teta_id"},
+		preload: []string{"TemplateTask"},
+	})}
 }
 
 func (r *provinceRepository) GetByName(ctx context.Context, name string) (*models.Province, error) {
@@ -15,6 +15,36 @@ const (
 	MaxLimit     = 100
 )
 
+// filter operators, written as field[op]=value in list queries; a bare field=value is eq.
+const (
+	FilterEq   = "eq"
+	FilterNe   = "ne"
+	FilterGt   = "gt"
+	FilterGte  = "gte"
+	FilterLt   = "lt"
+	FilterLte  = "lte"
+	FilterIn   = "in"
+	FilterLike = "like"
+	FilterNull = "null"
+)
+
+var filterComparisons = map[string]string{
+	FilterEq:  "=",
+	FilterNe:  "<>",
+	FilterGt:  ">",
+	FilterGte: ">=",
+	FilterLt:  "<",
+	FilterLte: "<=",
+}
+
+// Filter is one column condition. Column must come from a whitelist, never from raw input; Value is
+// bound as a query parameter ([]interface{} for in, bool for null).
+type Filter struct {
+	Column   string
+	Operator string
+	Value    interface{}
+}
+
 // ListOptions contains common query options for list endpoints.
 type ListOptions struct {
 	Page         int
@@ -23,13 +53,23 @@ type ListOptions struct {
 	SortOrder    string
 	Search       string
 	SearchFields []string
+	Filters      []Filter
+	// Fields narrows the selected columns; empty selects the whole row.
+	Fields []string
+
+	// Cursor and IncludeTotal are only read by ListCursor; an empty cursor asks for the first page.
+	Cursor       string
+	IncludeTotal bool
 
-	// AllowedSortFields is optional whitelist for sort_by.
+	// AllowedSortFields is the whitelist for sort_by; an empty list allows no sorting.
 	AllowedSortFields []string
 }
 
 var sortFieldPattern = regexp.MustCompile(`^[a-zA-Z0-9_\.]+$`)
 
+// likeEscaper makes % and _ in a like filter match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func normalizeListOptions(opts ListOptions) (ListOptions, error) {
 	if opts.Page == 0 {
 		opts.Page = DefaultPage
@@ -46,22 +86,11 @@ func normalizeListOptions(opts ListOptions) (ListOptions, error) {
 	}
 
 	if opts.SortBy != "" {
-		opts.SortBy = strings.TrimSpace(opts.SortBy)
-		if !sortFieldPattern.MatchString(opts.SortBy) {
-			return opts, errs.ErrInvalidSort
-		}
-		if len(opts.AllowedSortFields) > 0 {
-			allowed := false
-			for _, field := range opts.AllowedSortFields {
-				if strings.EqualFold(strings.TrimSpace(field), opts.SortBy) {
-					allowed = true
-					break
-				}
-			}
-			if !allowed {
-				return opts, errs.ErrInvalidSort
-			}
+		sortBy, ok := allowedSortField(opts.AllowedSortFields, opts.SortBy)
+		if !ok {
+			return opts, &errs.SortError{Field: strings.TrimSpace(opts.SortBy), Allowed: opts.AllowedSortFields}
 		}
+		opts.SortBy = sortBy
 
 		order := strings.ToUpper(strings.TrimSpace(opts.SortOrder))
 		if order == "" {
@@ -78,10 +107,34 @@ func normalizeListOptions(opts ListOptions) (ListOptions, error) {
 		return opts, errs.ErrInvalidSearch
 	}
 
+	for _, filter := range opts.Filters {
+		if !sortFieldPattern.MatchString(filter.Column) {
+			return opts, errs.ErrInvalidFilter
+		}
+	}
+
 	return opts, nil
 }
 
+// allowedSortField matches sortBy case-insensitively against the whitelist and returns the declared column,
+// so ORDER BY never carries request text.
+func allowedSortField(allowed []string, sortBy string) (string, bool) {
+	sortBy = strings.TrimSpace(sortBy)
+	for _, field := range allowed {
+		field = strings.TrimSpace(field)
+		if strings.EqualFold(field, sortBy) && sortFieldPattern.MatchString(field) {
+			return field, true
+		}
+	}
+	return "", false
+}
+
 func applySearchAndSort(query *gorm.DB, opts ListOptions) (*gorm.DB, error) {
+	query, err := applyFilters(query, opts.Filters)
+	if err != nil {
+		return nil, err
+	}
+
 	if opts.Search != "" {
 		clauses := make([]string, 0, len(opts.SearchFields))
 		args := make([]interface{}, 0, len(opts.SearchFields))
@@ -108,3 +161,36 @@ func applySearchAndSort(query *gorm.DB, opts ListOptions) (*gorm.DB, error) {
 
 	return query, nil
 }
+
+func applyFilters(query *gorm.DB, filters []Filter) (*gorm.DB, error) {
+	for _, filter := range filters {
+		if comparison, ok := filterComparisons[filter.Operator]; ok {
+			query = query.Where(fmt.Sprintf("%s %s ?", filter.Column, comparison), filter.Value)
+			continue
+		}
+
+		switch filter.Operator {
+		case FilterIn:
+			values, ok := filter.Value.([]interface{})
+			if !ok || len(values) == 0 {
+				return nil, errs.ErrInvalidFilter
+			}
+			query = query.Where(fmt.Sprintf("%s IN ?", filter.Column), values)
+		case FilterLike:
+			query = query.Where(fmt.Sprintf("%s ILIKE ?", filter.Column), "%"+likeEscaper.Replace(fmt.Sprint(filter.Value))+"%")
+		case FilterNull:
+			isNull, ok := filter.Value.(bool)
+			if !ok {
+				return nil, errs.ErrInvalidFilter
+			}
+			if isNull {
+				query = query.Where(fmt.Sprintf("%s IS NULL", filter.Column))
+			} else {
+				query = query.Where(fmt.Sprintf("%s IS NOT NULL", filter.Column))
+			}
+		default:
+			return nil, errs.ErrInvalidFilter
+		}
+	}
+	return query, nil
+}
@@ -30,11 +30,19 @@ type paymentRepository struct {
 }
 
 func NewPaymentScheduleRepository(db *gorm.DB) PaymentScheduleRepository {
-	return &paymentScheduleRepository{baseRepository: newBaseRepository[models.PaymentSchedule](db)}
+	return &paymentScheduleRepository{baseRepository: newBaseRepository[models.PaymentSchedule](db, listConfig{
+		search:  []string{"status_pembayaran"},
+		sort:    []string{"schedule_id", "angsuran_ke", "jatuh_tempo", "total_tagihan", "status_pembayaran", "tanggal_bayar", "contract_id"},
+		preload: []string{"Contract", "Payments"},
+	})}
 }
 
 func NewPaymentRepository(db *gorm.DB) PaymentRepository {
-	return &paymentRepository{baseRepository: newBaseRepository[models.Payment](db)}
+	return &paymentRepository{baseRepository: newBaseRepository[models.Payment](db, listConfig{
+		search:  []string{"nomor_bukti", "metode_pembayaran", "provider"},
+		sort:    []string{"payment_id", "nomor_bukti", "jumlah_bayar", "tanggal_bayar", "contract_id", "created_at"},
+		preload: []string{"Contract", "Schedule", "Allocations", "Allocations.Schedule"},
+	})}
 }
 
 func (r *paymentScheduleRepository) ListByContractID(ctx context.Context, contractID int64) ([]models.PaymentSchedule, error) {
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"sort"
+	"strings"
+
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+)
+
+// MaxPreloadDepth caps relation chains such as Customer.Location; each level is one more query and
+// collection levels multiply the rows loaded.
+const MaxPreloadDepth = 2
+
+// resolvePreloads matches the requested relations case-insensitively against the declared ones and returns
+// the declared names, deduplicated and ordered parents first. GORM loads every relation with a single
+// IN (...) query over the parent keys, so a list costs one query per relation whatever its page size.
+func resolvePreloads(allowed, requested []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(requested))
+	resolved := make([]string, 0, len(requested))
+	for _, relation := range requested {
+		relation = strings.TrimSpace(relation)
+		if relation == "" {
+			continue
+		}
+		if strings.Count(relation, ".")+1 > MaxPreloadDepth {
+			return nil, &errs.PreloadError{Relation: relation, Reason: "nested deeper than the allowed depth", Allowed: allowed}
+		}
+
+		declared, ok := allowedPreload(allowed, relation)
+		if !ok {
+			reason := "unknown relation"
+			if len(allowed) == 0 {
+				reason = "this resource has no preloadable relations"
+			}
+			return nil, &errs.PreloadError{Relation: relation, Reason: reason, Allowed: allowed}
+		}
+		if _, dup := seen[declared]; dup {
+			continue
+		}
+		seen[declared] = struct{}{}
+		resolved = append(resolved, declared)
+	}
+
+	sort.SliceStable(resolved, func(i, j int) bool {
+		return strings.Count(resolved[i], ".") < strings.Count(resolved[j], ".")
+	})
+	return resolved, nil
+}
+
+func allowedPreload(allowed []string, relation string) (string, bool) {
+	for _, declared := range allowed {
+		if strings.EqualFold(declared, relation) {
+			return declared, true
+		}
+	}
+	return "", false
+}
@@ -26,14 +26,16 @@ type MSTRepositories struct {
 }
 
 type DealerRepositories struct {
-	MotorType  MotorTypeRepository
-	Motor      MotorRepository
-	MotorAsset MotorAssetRepository
-	Customer   CustomerRepository
+	MotorType         MotorTypeRepository
+	Motor             MotorRepository
+	MotorAsset        MotorAssetRepository
+	Customer          CustomerRepository
+	CustomerBlacklist CustomerBlacklistRepository
 }
 
 type LeasingRepositories struct {
 	LeasingProduct          LeasingProductRepository
+	InsuranceRate           InsuranceRateRepository
 	LeasingContract         LeasingContractRepository
 	LeasingTask             LeasingTaskRepository
 	LeasingTaskAttribute    LeasingTaskAttributeRepository
@@ -77,13 +79,15 @@ func NewRepositories(db *gorm.DB) *Repositories {
 			TemplateTaskAttribute: NewTemplateTaskAttributeRepository(db),
 		},
 		Dealer: DealerRepositories{
-			MotorType:  NewMotorTypeRepository(db),
-			Motor:      NewMotorRepository(db),
-			MotorAsset: NewMotorAssetRepository(db),
-			Customer:   NewCustomerRepository(db),
+			MotorType:         NewMotorTypeRepository(db),
+			Motor:             NewMotorRepository(db),
+			MotorAsset:        NewMotorAssetRepository(db),
+			Customer:          NewCustomerRepository(db),
+			CustomerBlacklist: NewCustomerBlacklistRepository(db),
 		},
 		Leasing: LeasingRepositories{
 			LeasingProduct:          NewLeasingProductRepository(db),
+			InsuranceRate:           NewInsuranceRateRepository(db),
 			LeasingContract:         NewLeasingContractRepository(db),
 			LeasingTask:             NewLeasingTaskRepository(db),
 			LeasingTaskAttribute:    NewLeasingTaskAttributeRepository(db),
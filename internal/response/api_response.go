@@ -22,12 +22,15 @@ type ErrorPayload struct {
 	Details interface{} `json:"details,omitempty"`
 }
 
-// PaginationMeta is optional pagination metadata for list endpoints.
+// PaginationMeta is optional pagination metadata for list endpoints. Page lists fill Page and the totals;
+// cursor lists fill NextCursor/HasMore and only carry totals when include_total was asked for.
 type PaginationMeta struct {
-	Page       int   `json:"page"`
-	Limit      int   `json:"limit"`
-	TotalItems int64 `json:"total_items"`
-	TotalPages int   `json:"total_pages"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	TotalItems *int64 `json:"total_items,omitempty"`
+	TotalPages *int   `json:"total_pages,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    *bool  `json:"has_more,omitempty"`
 }
 
 // JSON returns a success response with optional data and meta.
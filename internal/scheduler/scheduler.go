@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JobFunc is one run of a scheduled job.
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Scheduler runs jobs in-process at a fixed interval. A run that is still busy when the next tick
+// arrives is not started twice; cross-replica exclusion is left to the job (e.g. an advisory lock).
+type Scheduler struct {
+	jobs []job
+	wg   sync.WaitGroup
+}
+
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job using an interval spec accepted by ParseInterval.
+func (s *Scheduler) Every(name, spec string, run JobFunc) error {
+	interval, err := ParseInterval(spec)
+	if err != nil {
+		return fmt.Errorf("scheduler job %s: %w", name, err)
+	}
+
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+	return nil
+}
+
+// Start launches every job in its own goroutine until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func(j job) {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+}
+
+// Wait blocks until all job goroutines have returned after ctx cancellation, including a run in progress.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// loop runs the job once right away, so a restart never delays it by a full interval, then on every tick.
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	log.Printf("scheduler: job %s every %s", j.name, j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runOnce does not start after ctx is cancelled, but a run already started drains: the job gets a context
+// that is not cancelled on shutdown, so a sweep is never cut off mid-transaction.
+func (s *Scheduler) runOnce(ctx context.Context, j job) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	started := time.Now()
+	if err := j.run(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("scheduler: job %s failed: %v", j.name, err)
+		return
+	}
+	log.Printf("scheduler: job %s finished in %s", j.name, time.Since(started).Round(time.Millisecond))
+}
+
+// ParseInterval accepts a Go duration ("15m") or the cron shorthands "@every <duration>", "@hourly", "@daily".
+func ParseInterval(spec string) (time.Duration, error) {
+	spec = strings.TrimSpace(spec)
+
+	var interval time.Duration
+	switch {
+	case spec == "@hourly":
+		interval = time.Hour
+	case spec == "@daily" || spec == "@midnight":
+		interval = 24 * time.Hour
+	case strings.HasPrefix(spec, "@every "):
+		parsed, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q: %w", spec, err)
+		}
+		interval = parsed
+	default:
+		parsed, err := time.ParseDuration(spec)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q: %w", spec, err)
+		}
+		interval = parsed
+	}
+
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid interval %q: must be positive", spec)
+	}
+	return interval, nil
+}
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWaitDrainsRunningJob cancels the scheduler while the startup run is in progress: the run must
+// finish with a live context and Wait must block until it has.
+func TestWaitDrainsRunningJob(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var finished atomic.Bool
+	var runs atomic.Int32
+
+	jobs := New()
+	err := jobs.Every("drain", "1h", func(ctx context.Context) error {
+		runs.Add(1)
+		close(started)
+		<-release
+		if ctx.Err() != nil {
+			t.Errorf("running job saw a cancelled context: %v", ctx.Err())
+		}
+		finished.Store(true)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Every error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	jobs.Start(ctx)
+	<-started
+	cancel()
+
+	waited := make(chan struct{})
+	go func() {
+		jobs.Wait()
+		close(waited)
+	}()
+
+	select {
+	case <-waited:
+		t.Fatal("Wait returned while the job was still running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-waited:
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return after the job finished")
+	}
+	if !finished.Load() {
+		t.Fatal("job did not finish")
+	}
+	if got := runs.Load(); got != 1 {
+		t.Fatalf("job ran %d times, want 1", got)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{spec: "15m", want: 15 * time.Minute},
+		{spec: "@every 2h", want: 2 * time.Hour},
+		{spec: "@hourly", want: time.Hour},
+		{spec: "@daily", want: 24 * time.Hour},
+		{spec: "", wantErr: true},
+		{spec: "0 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseInterval(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseInterval(%q) = %s, want error", tt.spec, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseInterval(%q) error: %v", tt.spec, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseInterval(%q) = %s, want %s", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
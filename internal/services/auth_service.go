@@ -0,0 +1,420 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultAccessTokenTTL  = 24 * time.Hour
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+	refreshTokenBytes      = 32
+	// jwtSecretPlaceholder is the value shipped in old sample configs; it is public and never accepted.
+	jwtSecretPlaceholder = "your-secret-key"
+)
+
+// AuthService issues and validates JWT access tokens backed by rotating refresh tokens.
+type AuthService interface {
+	Login(ctx context.Context, input LoginInput) (*AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Authenticate(ctx context.Context, accessToken string) (*AuthClaims, error)
+	// SetPassword bootstraps or resets a password out of band (CLI) and revokes the user's sessions.
+	SetPassword(ctx context.Context, identifier, password string) error
+}
+
+type LoginInput struct {
+	Identifier string
+	Password   string
+}
+
+type AuthTokens struct {
+	AccessToken      string    `json:"access_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	UserID           int64     `json:"user_id"`
+	Roles            []string  `json:"roles"`
+}
+
+// AuthClaims is the JWT payload. SessionID points to the refresh token that minted the access token.
+type AuthClaims struct {
+	UserID    int64    `json:"uid"`
+	Roles     []string `json:"roles"`
+	SessionID int64    `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+type authService struct {
+	db                *gorm.DB
+	secret            []byte
+	issuer            string
+	accessTTL         time.Duration
+	refreshTTL        time.Duration
+	maxFailedAttempts int
+	lockoutDuration   time.Duration
+}
+
+// NewAuthService refuses an empty or placeholder JWT.SECRET: anyone knowing it could forge access tokens.
+func NewAuthService(db *gorm.DB, jwtCfg configs.JWTConfig, authCfg configs.AuthConfig) (AuthService, error) {
+	secret := strings.TrimSpace(jwtCfg.Secret)
+	if secret == "" || secret == jwtSecretPlaceholder {
+		return nil, fmt.Errorf("auth: JWT.SECRET must be set to a private value")
+	}
+
+	accessTTL := time.Duration(jwtCfg.ExpiryHours) * time.Hour
+	if accessTTL <= 0 {
+		accessTTL = defaultAccessTokenTTL
+	}
+	refreshTTL := time.Duration(jwtCfg.RefreshExpiryHours) * time.Hour
+	if refreshTTL <= 0 {
+		refreshTTL = defaultRefreshTokenTTL
+	}
+
+	return &authService{
+		db:                db,
+		secret:            []byte(secret),
+		issuer:            strings.TrimSpace(jwtCfg.Issuer),
+		accessTTL:         accessTTL,
+		refreshTTL:        refreshTTL,
+		maxFailedAttempts: authCfg.MaxFailedAttempts,
+		lockoutDuration:   time.Duration(authCfg.LockoutMinutes) * time.Minute,
+	}, nil
+}
+
+func (s *authService) Login(ctx context.Context, input LoginInput) (*AuthTokens, error) {
+	identifier := strings.TrimSpace(input.Identifier)
+	if identifier == "" || strings.TrimSpace(input.Password) == "" {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var tokens *AuthTokens
+	var loginErr error
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("username = ? OR email = ? OR phone_number = ?", identifier, identifier, identifier).
+			First(&user).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				loginErr = errs.ErrInvalidCredentials
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		if !user.IsActive {
+			loginErr = errs.ErrAccountInactive
+			return nil
+		}
+		if user.LockedUntil != nil && user.LockedUntil.After(now) {
+			loginErr = errs.ErrAccountLocked
+			return nil
+		}
+
+		if err := comparePasswordWithBcrypt(user.Password, input.Password); err != nil {
+			// failed attempts must be persisted, so the transaction commits and the error is returned afterwards
+			loginErr = errs.ErrInvalidCredentials
+			return s.registerFailedLogin(tx, &user, now)
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("user_id = ?", user.UserID).
+			Updates(map[string]interface{}{
+				"last_login":      now,
+				"failed_attempts": 0,
+				"locked_until":    nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		roles, err := loadUserRoleNames(tx, user.UserID)
+		if err != nil {
+			return err
+		}
+
+		issued, err := s.issueTokens(tx, user.UserID, roles, now)
+		if err != nil {
+			return err
+		}
+		tokens = issued.AuthTokens
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if loginErr != nil {
+		return nil, loginErr
+	}
+
+	return tokens, nil
+}
+
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (*AuthTokens, error) {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return nil, errs.ErrInvalidToken
+	}
+
+	var tokens *issuedTokens
+	var refreshErr error
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var stored models.RefreshToken
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", hashRefreshToken(refreshToken)).
+			First(&stored).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				refreshErr = errs.ErrInvalidToken
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now().UTC()
+		if stored.RevokedAt != nil {
+			// a rotated token was presented again: treat it as stolen and end every session of the user
+			refreshErr = errs.ErrInvalidToken
+			return revokeUserRefreshTokens(tx, stored.UserID, now)
+		}
+		if !stored.ExpiresAt.After(now) {
+			refreshErr = errs.ErrInvalidToken
+			return nil
+		}
+
+		var user models.User
+		if err := tx.First(&user, "user_id = ?", stored.UserID).Error; err != nil {
+			return err
+		}
+		if !user.IsActive {
+			refreshErr = errs.ErrAccountInactive
+			return revokeUserRefreshTokens(tx, user.UserID, now)
+		}
+
+		roles, err := loadUserRoleNames(tx, user.UserID)
+		if err != nil {
+			return err
+		}
+
+		tokens, err = s.issueTokens(tx, user.UserID, roles, now)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.RefreshToken{}).
+			Where("token_id = ?", stored.TokenID).
+			Updates(map[string]interface{}{
+				"revoked_at":     now,
+				"replaced_by_id": tokens.sessionID,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if refreshErr != nil {
+		return nil, refreshErr
+	}
+
+	return tokens.AuthTokens, nil
+}
+
+func (s *authService) Logout(ctx context.Context, refreshToken string) error {
+	refreshToken = strings.TrimSpace(refreshToken)
+	if refreshToken == "" {
+		return errs.ErrInvalidToken
+	}
+
+	tx := s.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("token_hash = ? AND revoked_at IS NULL", hashRefreshToken(refreshToken)).
+		Update("revoked_at", time.Now().UTC())
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return errs.ErrInvalidToken
+	}
+
+	return nil
+}
+
+func (s *authService) SetPassword(ctx context.Context, identifier, password string) error {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return errs.ErrInvalidInput
+	}
+	hashed, err := hashPasswordWithBcrypt(password)
+	if err != nil {
+		return err
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("username = ? OR email = ? OR phone_number = ?", identifier, identifier, identifier).
+			First(&user).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if err := tx.Model(&models.User{}).
+			Where("user_id = ?", user.UserID).
+			Updates(map[string]interface{}{
+				"password":        hashed,
+				"failed_attempts": 0,
+				"locked_until":    nil,
+			}).Error; err != nil {
+			return err
+		}
+		return revokeUserRefreshTokens(tx, user.UserID, now)
+	})
+}
+
+func (s *authService) Authenticate(ctx context.Context, accessToken string) (*AuthClaims, error) {
+	accessToken = strings.TrimSpace(accessToken)
+	if accessToken == "" {
+		return nil, errs.ErrInvalidToken
+	}
+
+	claims := &AuthClaims{}
+	keyFunc := func(token *jwt.Token) (interface{}, error) { return s.secret, nil }
+	parsed, err := jwt.ParseWithClaims(accessToken, claims, keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !parsed.Valid || claims.UserID < 1 {
+		return nil, errs.ErrInvalidToken
+	}
+
+	// logout and rotation revoke the session, which also invalidates access tokens minted from it
+	var active int64
+	if err := s.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("token_id = ? AND user_id = ? AND revoked_at IS NULL", claims.SessionID, claims.UserID).
+		Count(&active).Error; err != nil {
+		return nil, err
+	}
+	if active == 0 {
+		return nil, errs.ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+type issuedTokens struct {
+	*AuthTokens
+	sessionID int64
+}
+
+func (s *authService) issueTokens(tx *gorm.DB, userID int64, roles []string, now time.Time) (*issuedTokens, error) {
+	rawRefresh, err := generateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiresAt := now.Add(s.refreshTTL)
+	stored := models.RefreshToken{
+		TokenHash: hashRefreshToken(rawRefresh),
+		ExpiresAt: refreshExpiresAt,
+		UserID:    userID,
+	}
+	if err := tx.Create(&stored).Error; err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(s.accessTTL)
+	claims := AuthClaims{
+		UserID:    userID,
+		Roles:     roles,
+		SessionID: stored.TokenID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuedTokens{
+		AuthTokens: &AuthTokens{
+			AccessToken:      signed,
+			TokenType:        "Bearer",
+			ExpiresAt:        expiresAt,
+			RefreshToken:     rawRefresh,
+			RefreshExpiresAt: refreshExpiresAt,
+			UserID:           userID,
+			Roles:            roles,
+		},
+		sessionID: stored.TokenID,
+	}, nil
+}
+
+func (s *authService) registerFailedLogin(tx *gorm.DB, user *models.User, now time.Time) error {
+	attempts := user.FailedAttempts + 1
+	updates := map[string]interface{}{
+		"failed_attempts": attempts,
+	}
+	if s.maxFailedAttempts > 0 && int(attempts) >= s.maxFailedAttempts && s.lockoutDuration > 0 {
+		updates["locked_until"] = now.Add(s.lockoutDuration)
+		updates["failed_attempts"] = 0
+	}
+
+	return tx.Model(&models.User{}).
+		Where("user_id = ?", user.UserID).
+		Updates(updates).Error
+}
+
+func loadUserRoleNames(tx *gorm.DB, userID int64) ([]string, error) {
+	roles := make([]string, 0)
+	err := tx.Model(&models.Role{}).
+		Joins("JOIN account.user_roles ur ON ur.role_id = account.roles.role_id").
+		Where("ur.user_id = ?", userID).
+		Order("account.roles.role_name ASC").
+		Pluck("account.roles.role_name", &roles).Error
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func revokeUserRefreshTokens(tx *gorm.DB, userID int64, now time.Time) error {
+	return tx.Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error
+}
+
+func generateRefreshToken() (string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+const defaultPermissionCacheTTL = 5 * time.Minute
+
+// permission types seeded in account.permissions
+const (
+	PermissionViewDashboard        = "view_dashboard"
+	PermissionViewContract         = "view_contract"
+	PermissionCreateContract       = "create_contract"
+	PermissionApproveContract      = "approve_contract"
+	PermissionViewSurvey           = "view_survey"
+	PermissionCreateSurvey         = "create_survey"
+	PermissionViewPayment          = "view_payment"
+	PermissionRecordPayment        = "record_payment"
+	PermissionManageUser           = "manage_user"
+	PermissionManageOAuth          = "manage_oauth"
+	PermissionExportReport         = "export_report"
+	PermissionSendNotif            = "send_notif"
+	PermissionManageMasterData     = "manage_master_data"
+	PermissionRecordInitialPayment = "record_initial_payment"
+	PermissionProcessPurchaseOrder = "process_purchase_order"
+)
+
+type actorContextKey struct{}
+
+// ContextWithActor stores the authenticated user ID so services can attribute changes (e.g. status history).
+func ContextWithActor(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, userID)
+}
+
+// ActorFromContext returns the user ID stored by ContextWithActor.
+func ActorFromContext(ctx context.Context) (int64, bool) {
+	userID, ok := ctx.Value(actorContextKey{}).(int64)
+	return userID, ok && userID > 0
+}
+
+// tables whose writes change the effective permissions of a user
+var authorizationTables = map[string]struct{}{
+	models.Role{}.TableName():           {},
+	models.UserRole{}.TableName():       {},
+	models.Permission{}.TableName():     {},
+	models.RolePermission{}.TableName(): {},
+}
+
+// AuthorizationService resolves permissions through UserRole -> RolePermission -> Permission.
+type AuthorizationService interface {
+	HasPermission(ctx context.Context, userID int64, permission string) (bool, error)
+	UserPermissions(ctx context.Context, userID int64) ([]string, error)
+	Invalidate()
+}
+
+type cachedPermissions struct {
+	permissions map[string]struct{}
+	loadedAt    time.Time
+}
+
+type authorizationService struct {
+	db  *gorm.DB
+	ttl time.Duration
+
+	mu    sync.RWMutex
+	cache map[int64]cachedPermissions
+	// generation changes on every Invalidate so a load that raced with it does not re-cache stale data
+	generation uint64
+}
+
+// NewAuthorizationService also hooks into GORM so any committed write to the role/permission tables drops the cache.
+// The TTL only bounds staleness for changes made outside this process.
+func NewAuthorizationService(db *gorm.DB, ttl time.Duration) AuthorizationService {
+	if ttl <= 0 {
+		ttl = defaultPermissionCacheTTL
+	}
+
+	s := &authorizationService{
+		db:    db,
+		ttl:   ttl,
+		cache: make(map[int64]cachedPermissions),
+	}
+	s.registerInvalidationCallbacks()
+	return s
+}
+
+func (s *authorizationService) HasPermission(ctx context.Context, userID int64, permission string) (bool, error) {
+	permission = strings.TrimSpace(permission)
+	if userID < 1 || permission == "" {
+		return false, nil
+	}
+
+	permissions, err := s.load(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := permissions[permission]
+	return ok, nil
+}
+
+func (s *authorizationService) UserPermissions(ctx context.Context, userID int64) ([]string, error) {
+	permissions, err := s.load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(permissions))
+	for permission := range permissions {
+		result = append(result, permission)
+	}
+	return result, nil
+}
+
+func (s *authorizationService) Invalidate() {
+	s.mu.Lock()
+	s.cache = make(map[int64]cachedPermissions)
+	s.generation++
+	s.mu.Unlock()
+}
+
+func (s *authorizationService) load(ctx context.Context, userID int64) (map[string]struct{}, error) {
+	now := time.Now()
+
+	s.mu.RLock()
+	cached, ok := s.cache[userID]
+	generation := s.generation
+	s.mu.RUnlock()
+	if ok && now.Sub(cached.loadedAt) < s.ttl {
+		return cached.permissions, nil
+	}
+
+	names := make([]string, 0)
+	err := s.db.WithContext(ctx).Model(&models.Permission{}).
+		Distinct("account.permissions.permission_type").
+		Joins("JOIN account.role_permission rp ON rp.permission_id = account.permissions.permission_id").
+		Joins("JOIN account.user_roles ur ON ur.role_id = rp.role_id").
+		Where("ur.user_id = ?", userID).
+		Pluck("account.permissions.permission_type", &names).Error
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		permissions[name] = struct{}{}
+	}
+
+	s.mu.Lock()
+	if s.generation == generation {
+		s.cache[userID] = cachedPermissions{permissions: permissions, loadedAt: now}
+	}
+	s.mu.Unlock()
+
+	return permissions, nil
+}
+
+// registerInvalidationCallbacks drops the cache once a write to the role/permission tables is committed.
+// The callbacks run after GORM commits the statement's own transaction, so a rolled back write keeps the
+// cache and a request that loaded before the commit cannot re-cache the old permissions. Writes made inside a
+// caller-managed transaction are not visible to the callbacks at commit time; such callers invalidate
+// after their transaction commits.
+func (s *authorizationService) registerInvalidationCallbacks() {
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.Statement == nil {
+			return
+		}
+		if _, ok := authorizationTables[tx.Statement.Table]; !ok {
+			return
+		}
+		if _, inTransaction := tx.Statement.ConnPool.(gorm.TxCommitter); inTransaction {
+			return
+		}
+		s.Invalidate()
+	}
+
+	callbacks := s.db.Callback()
+	_ = callbacks.Create().After("gorm:commit_or_rollback_transaction").Register("authorization:invalidate_create", invalidate)
+	_ = callbacks.Update().After("gorm:commit_or_rollback_transaction").Register("authorization:invalidate_update", invalidate)
+	_ = callbacks.Delete().After("gorm:commit_or_rollback_transaction").Register("authorization:invalidate_delete", invalidate)
+}
@@ -10,8 +10,10 @@ import (
 type CRUDService[T any] interface {
 	Create(ctx context.Context, entity *T) error
 	GetByID(ctx context.Context, id int64, preloads ...string) (*T, error)
+	GetByIDFields(ctx context.Context, id int64, fields []string, preloads ...string) (*T, error)
 	FindOne(ctx context.Context, condition interface{}, args ...interface{}) (*T, error)
 	List(ctx context.Context, opts repository.ListOptions, preloads ...string) ([]T, int64, error)
+	ListCursor(ctx context.Context, opts repository.ListOptions, preloads ...string) ([]T, *repository.CursorPage, error)
 	Update(ctx context.Context, id int64, updates map[string]interface{}) error
 	Delete(ctx context.Context, id int64) error
 }
@@ -32,6 +34,10 @@ func (s *baseService[T]) GetByID(ctx context.Context, id int64, preloads ...stri
 	return s.repo.GetByID(ctx, id, preloads...)
 }
 
+func (s *baseService[T]) GetByIDFields(ctx context.Context, id int64, fields []string, preloads ...string) (*T, error) {
+	return s.repo.GetByIDFields(ctx, id, fields, preloads...)
+}
+
 func (s *baseService[T]) FindOne(ctx context.Context, condition interface{}, args ...interface{}) (*T, error) {
 	return s.repo.FindOne(ctx, condition, args...)
 }
@@ -40,6 +46,10 @@ func (s *baseService[T]) List(ctx context.Context, opts repository.ListOptions,
 	return s.repo.List(ctx, opts, preloads...)
 }
 
+func (s *baseService[T]) ListCursor(ctx context.Context, opts repository.ListOptions, preloads ...string) ([]T, *repository.CursorPage, error) {
+	return s.repo.ListCursor(ctx, opts, preloads...)
+}
+
 func (s *baseService[T]) Update(ctx context.Context, id int64, updates map[string]interface{}) error {
 	return s.repo.Update(ctx, id, updates)
 }
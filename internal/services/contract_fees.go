@@ -0,0 +1,77 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+)
+
+// contractFees are the one-off charges of a contract, split by whether the product finances them
+// (added to pokok) or collects them upfront together with the DP.
+type contractFees struct {
+	AdminFee      money.Amount
+	AsuransiPremi money.Amount
+	BiayaFidusia  money.Amount
+	Dibiayai      money.Amount
+	Dimuka        money.Amount
+}
+
+// computeContractFees prices admin fee, insurance premium and fidusia fee for a product.
+// The premium is rate_persen of OTR from leasing.insurance_rates; motyID nil uses the generic rate only.
+func computeContractFees(tx *gorm.DB, product *models.LeasingProduct, motyID *int64, nilaiKendaraan money.Amount, tenor int16) (contractFees, error) {
+	var fees contractFees
+	fees.add(product.AdminFee.Round(), product.AdminFeeDibiayai, &fees.AdminFee)
+	fees.add(product.BiayaFidusia.Round(), product.FidusiaDibiayai, &fees.BiayaFidusia)
+
+	if product.Asuransi {
+		rate, err := findInsuranceRate(tx, motyID, tenor)
+		if err != nil {
+			return contractFees{}, err
+		}
+		fees.add(nilaiKendaraan.MulRate(rate.RatePersen), product.AsuransiDibiayai, &fees.AsuransiPremi)
+	}
+
+	return fees, nil
+}
+
+func (f *contractFees) add(amount money.Amount, dibiayai bool, field *money.Amount) {
+	if amount.Sign() <= 0 {
+		return
+	}
+	*field = amount
+	if dibiayai {
+		f.Dibiayai = f.Dibiayai.Add(amount)
+		return
+	}
+	f.Dimuka = f.Dimuka.Add(amount)
+}
+
+func (f contractFees) apply(contract *models.LeasingContract) {
+	contract.AdminFee = f.AdminFee
+	contract.AsuransiPremi = f.AsuransiPremi
+	contract.BiayaFidusia = f.BiayaFidusia
+	contract.BiayaDibiayai = f.Dibiayai
+	contract.BiayaDimuka = f.Dimuka
+}
+
+// findInsuranceRate prefers the rate of the motor type and falls back to the generic (moty_id NULL) rate.
+func findInsuranceRate(tx *gorm.DB, motyID *int64, tenor int16) (*models.InsuranceRate, error) {
+	query := tx.Where("tenor_bulan = ?", tenor)
+	if motyID != nil {
+		query = query.Where("moty_id = ? OR moty_id IS NULL", *motyID)
+	} else {
+		query = query.Where("moty_id IS NULL")
+	}
+
+	var rate models.InsuranceRate
+	if err := query.Order("moty_id ASC NULLS LAST").First(&rate).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrInsuranceRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"gorm.io/gorm"
+)
+
+// contract actions, used as edge labels in the state graph
+const (
+	ContractActionApprove   = "approve"
+	ContractActionRework    = "rework"
+	ContractActionActivate  = "activate"
+	ContractActionMarkLate  = "mark_late"
+	ContractActionCure      = "cure"
+	ContractActionPayOff    = "pay_off"
+	ContractActionRepossess = "repossess"
+	ContractActionRedeem    = "redeem"
+	ContractActionCancel    = "cancel"
+)
+
+type contractGuard func(tx *gorm.DB, contract *models.LeasingContract) error
+type contractEffect func(tx *gorm.DB, contract *models.LeasingContract) error
+
+type contractTransition struct {
+	from   string
+	to     string
+	action string
+	guard  contractGuard
+	effect contractEffect
+}
+
+// ContractTransitionView is the public shape of one edge of the contract state graph.
+type ContractTransitionView struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Action string `json:"action"`
+}
+
+// ContractTransitionAudit carries who changed the status and why, for the status history.
+type ContractTransitionAudit struct {
+	ActorID int64
+	Reason  string
+}
+
+type ContractStateGraph struct {
+	States      []string                 `json:"states"`
+	Initial     string                   `json:"initial"`
+	Terminal    []string                 `json:"terminal"`
+	Transitions []ContractTransitionView `json:"transitions"`
+}
+
+// ContractStateMachine is the single place that decides which contract status changes are allowed.
+// Guards run before the status is written, side effects after, all inside the caller's transaction.
+type ContractStateMachine struct {
+	states      []string
+	transitions []contractTransition
+	index       map[string]map[string]*contractTransition
+}
+
+func NewContractStateMachine() *ContractStateMachine {
+	m := &ContractStateMachine{
+		states: []string{
+			ContractStatusDraft,
+			ContractStatusApproved,
+			ContractStatusActive,
+			ContractStatusLate,
+			ContractStatusPaidOff,
+			ContractStatusRepo,
+			ContractStatusCanceled,
+		},
+		transitions: []contractTransition{
+			{from: ContractStatusDraft, to: ContractStatusApproved, action: ContractActionApprove},
+			{from: ContractStatusDraft, to: ContractStatusCanceled, action: ContractActionCancel, effect: releaseBookedMotor},
+			{from: ContractStatusApproved, to: ContractStatusDraft, action: ContractActionRework}, // additional DP / rework setelah survey
+			{from: ContractStatusApproved, to: ContractStatusActive, action: ContractActionActivate, guard: requireInstallmentStart, effect: setMotorStatus(MotorStatusLeased)},
+			{from: ContractStatusApproved, to: ContractStatusCanceled, action: ContractActionCancel, effect: releaseBookedMotor},
+			{from: ContractStatusActive, to: ContractStatusLate, action: ContractActionMarkLate, guard: requireOverdueInstallment},
+			{from: ContractStatusActive, to: ContractStatusPaidOff, action: ContractActionPayOff, guard: requireNoOutstandingInstallment},
+			{from: ContractStatusActive, to: ContractStatusCanceled, action: ContractActionCancel, effect: setMotorStatus(MotorStatusReturned)},
+			{from: ContractStatusLate, to: ContractStatusActive, action: ContractActionCure, guard: requireNoOverdueInstallment},
+			{from: ContractStatusLate, to: ContractStatusPaidOff, action: ContractActionPayOff, guard: requireNoOutstandingInstallment},
+			{from: ContractStatusLate, to: ContractStatusRepo, action: ContractActionRepossess, effect: setMotorStatus(MotorStatusRepo)},
+			{from: ContractStatusRepo, to: ContractStatusActive, action: ContractActionRedeem, guard: requireNoOverdueInstallment, effect: setMotorStatus(MotorStatusLeased)},
+		},
+		index: make(map[string]map[string]*contractTransition),
+	}
+
+	for i := range m.transitions {
+		t := &m.transitions[i]
+		if m.index[t.from] == nil {
+			m.index[t.from] = make(map[string]*contractTransition)
+		}
+		m.index[t.from][t.to] = t
+	}
+
+	return m
+}
+
+func (m *ContractStateMachine) CanTransition(from, to string) bool {
+	_, ok := m.index[from][to]
+	return ok
+}
+
+// Transition moves the contract to the next status and appends it to the status history.
+// Moving to the current status is a no-op.
+func (m *ContractStateMachine) Transition(tx *gorm.DB, contract *models.LeasingContract, next string, audit ContractTransitionAudit) error {
+	next = strings.TrimSpace(strings.ToLower(next))
+	if contract.Status == next {
+		return nil
+	}
+
+	t, ok := m.index[contract.Status][next]
+	if !ok {
+		return errs.ErrInvalidStatusTransition
+	}
+	if t.guard != nil {
+		if err := t.guard(tx, contract); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Model(&models.LeasingContract{}).
+		Where("contract_id = ?", contract.ContractID).
+		Update("status", next).Error; err != nil {
+		return err
+	}
+	previous := contract.Status
+	contract.Status = next
+
+	if err := recordContractStatus(tx, contract.ContractID, &previous, next, audit); err != nil {
+		return err
+	}
+
+	if t.effect != nil {
+		return t.effect(tx, contract)
+	}
+	return nil
+}
+
+// AllowedTransitions lists the edges leaving the given status, in declaration order.
+func (m *ContractStateMachine) AllowedTransitions(from string) []ContractTransitionView {
+	result := make([]ContractTransitionView, 0)
+	for _, t := range m.transitions {
+		if t.from == from {
+			result = append(result, ContractTransitionView{From: t.from, To: t.to, Action: t.action})
+		}
+	}
+	return result
+}
+
+func (m *ContractStateMachine) Graph() ContractStateGraph {
+	graph := ContractStateGraph{
+		States:      append([]string(nil), m.states...),
+		Initial:     ContractStatusDraft,
+		Terminal:    make([]string, 0),
+		Transitions: make([]ContractTransitionView, 0, len(m.transitions)),
+	}
+	for _, state := range m.states {
+		if len(m.index[state]) == 0 {
+			graph.Terminal = append(graph.Terminal, state)
+		}
+	}
+	for _, t := range m.transitions {
+		graph.Transitions = append(graph.Transitions, ContractTransitionView{From: t.from, To: t.to, Action: t.action})
+	}
+	return graph
+}
+
+// recordContractStatus appends one row to leasing.contract_status_history; from is nil for a new contract.
+func recordContractStatus(tx *gorm.DB, contractID int64, from *string, to string, audit ContractTransitionAudit) error {
+	history := models.ContractStatusHistory{
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     strings.TrimSpace(audit.Reason),
+		ContractID: contractID,
+	}
+	if audit.ActorID > 0 {
+		actorID := audit.ActorID
+		history.ActorID = &actorID
+	}
+	return tx.Create(&history).Error
+}
+
+func requireInstallmentStart(_ *gorm.DB, contract *models.LeasingContract) error {
+	if contract.TanggalMulaiCicil.IsZero() || contract.TenorBulan <= 0 {
+		return errs.ErrInvalidInput
+	}
+	return nil
+}
+
+func requireOverdueInstallment(tx *gorm.DB, contract *models.LeasingContract) error {
+	count, err := countOverdueInstallments(tx, contract.ContractID)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errs.ErrContractNotOverdue
+	}
+	return nil
+}
+
+func requireNoOverdueInstallment(tx *gorm.DB, contract *models.LeasingContract) error {
+	count, err := countOverdueInstallments(tx, contract.ContractID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrContractOverdue
+	}
+	return nil
+}
+
+// requireNoOutstandingInstallment only passes when the contract has a schedule and every row is paid;
+// a contract without installment rows has nothing that proves it was paid off.
+func requireNoOutstandingInstallment(tx *gorm.DB, contract *models.LeasingContract) error {
+	var counts struct {
+		Total  int64
+		Unpaid int64
+	}
+	if err := tx.Model(&models.PaymentSchedule{}).
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE status_pembayaran <> ?) AS unpaid", ScheduleStatusPaid).
+		Where("contract_id = ?", contract.ContractID).
+		Scan(&counts).Error; err != nil {
+		return err
+	}
+	if counts.Total == 0 {
+		return errs.ErrNoPaymentSchedule
+	}
+	if counts.Unpaid > 0 {
+		return errs.ErrContractOutstanding
+	}
+	return nil
+}
+
+// countOverdueInstallments counts unpaid rows whose due date has passed, whether or not they are flagged overdue yet.
+func countOverdueInstallments(tx *gorm.DB, contractID int64) (int64, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	var count int64
+	err := tx.Model(&models.PaymentSchedule{}).
+		Where("contract_id = ? AND status_pembayaran <> ? AND jatuh_tempo < ?", contractID, ScheduleStatusPaid, today).
+		Count(&count).Error
+	return count, err
+}
+
+func releaseBookedMotor(tx *gorm.DB, contract *models.LeasingContract) error {
+	return tx.Model(&models.Motor{}).
+		Where("motor_id = ? AND status_unit = ?", contract.MotorID, MotorStatusBooked).
+		Update("status_unit", MotorStatusReady).Error
+}
+
+func setMotorStatus(status string) contractEffect {
+	return func(tx *gorm.DB, contract *models.LeasingContract) error {
+		return tx.Model(&models.Motor{}).
+			Where("motor_id = ?", contract.MotorID).
+			Update("status_unit", status).Error
+	}
+}
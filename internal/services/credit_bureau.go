@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/creditbureau"
+	"gorm.io/gorm"
+)
+
+// CreditBureauClient checks a debtor against an external credit bureau (SLIK OJK) during auto scoring.
+type CreditBureauClient interface {
+	Check(ctx context.Context, inquiry creditbureau.Inquiry) (*creditbureau.Report, error)
+}
+
+// NewCreditBureauClient builds the configured client; provider none returns nil and scoring skips the bureau.
+// The fake client is only built when selected explicitly; an empty or unknown provider is a startup error so
+// a typo never scores real applicants from fake grades.
+func NewCreditBureauClient(cfg configs.BureauConfig) (CreditBureauClient, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Provider)) {
+	case creditbureau.ProviderNone:
+		return nil, nil
+	case creditbureau.ProviderHTTP:
+		if strings.TrimSpace(cfg.BaseURL) == "" {
+			return nil, fmt.Errorf("credit bureau: CREDIT_BUREAU.BASE_URL is required for provider %q", creditbureau.ProviderHTTP)
+		}
+		return creditbureau.NewHTTPClient(cfg.BaseURL, cfg.APIKey, time.Duration(cfg.TimeoutSeconds)*time.Second), nil
+	case creditbureau.ProviderFake:
+		log.Printf("credit bureau: using the fake client, grades are derived from the NIK")
+		return creditbureau.NewFakeClient(), nil
+	}
+
+	return nil, fmt.Errorf("credit bureau: unknown CREDIT_BUREAU.PROVIDER %q (use none, http or fake)", cfg.Provider)
+}
+
+// creditBureauReport reuses the customer's latest unexpired report, otherwise asks the bureau
+// and stores the answer for CacheDays.
+func (s *leasingWorkflowService) creditBureauReport(ctx context.Context, db *gorm.DB, customer *models.Customer) (*models.CreditBureauReport, error) {
+	now := time.Now().UTC()
+
+	var cached models.CreditBureauReport
+	err := db.Where("customer_id = ? AND expires_at > ?", customer.CustomerID, now).
+		Order("checked_at DESC").
+		Limit(1).
+		Find(&cached).Error
+	if err != nil {
+		return nil, err
+	}
+	if cached.ReportID > 0 {
+		return &cached, nil
+	}
+
+	answer, err := s.bureau.Check(ctx, creditbureau.Inquiry{
+		NIK:          customer.NIK,
+		NamaLengkap:  customer.NamaLengkap,
+		TanggalLahir: customer.TanggalLahir,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := models.CreditBureauReport{
+		Provider:         answer.Provider,
+		Kolektibilitas:   int16(answer.Kolektibilitas),
+		JumlahFasilitas:  answer.JumlahFasilitas,
+		TotalOutstanding: answer.TotalOutstanding,
+		CheckedAt:        now,
+		ExpiresAt:        now.AddDate(0, 0, s.bureauCacheDays),
+		CustomerID:       customer.CustomerID,
+	}
+	if ref := strings.TrimSpace(answer.ReferenceID); ref != "" {
+		report.ReferenceID = &ref
+	}
+	if err := db.Create(&report).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// lookupCreditBureau runs before the scoring transaction so the bureau call does not hold the contract lock.
+// A failing bureau is logged and reported as unavailable rather than failing the request.
+func (s *leasingWorkflowService) lookupCreditBureau(ctx context.Context, contractID int64) (*models.CreditBureauReport, error) {
+	if s.bureau == nil {
+		return nil, nil
+	}
+
+	db := s.db.WithContext(ctx)
+	var contract models.LeasingContract
+	if err := db.Select("contract_id", "customer_id").First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return nil, err
+	}
+	var customer models.Customer
+	if err := db.First(&customer, "customer_id = ?", contract.CustomerID).Error; err != nil {
+		return nil, err
+	}
+
+	report, err := s.creditBureauReport(ctx, db, &customer)
+	if err != nil {
+		log.Printf("credit bureau: check for customer %d failed: %v", customer.CustomerID, err)
+		return nil, nil
+	}
+	return report, nil
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"testing"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/creditbureau"
+)
+
+func TestNewCreditBureauClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        configs.BureauConfig
+		wantClient bool
+		wantFake   bool
+		wantErr    bool
+	}{
+		{name: "none skips the bureau", cfg: configs.BureauConfig{Provider: "none"}},
+		{name: "fake when selected", cfg: configs.BureauConfig{Provider: " Fake "}, wantClient: true, wantFake: true},
+		{name: "http", cfg: configs.BureauConfig{Provider: "http", BaseURL: "http://slik.local"}, wantClient: true},
+		{name: "http without base url", cfg: configs.BureauConfig{Provider: "http"}, wantErr: true},
+		{name: "empty provider", cfg: configs.BureauConfig{Provider: ""}, wantErr: true},
+		{name: "typo", cfg: configs.BureauConfig{Provider: "fakee"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewCreditBureauClient(tt.cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewCreditBureauClient(%q) = %T, want error", tt.cfg.Provider, client)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewCreditBureauClient(%q) error: %v", tt.cfg.Provider, err)
+			}
+			if (client != nil) != tt.wantClient {
+				t.Fatalf("NewCreditBureauClient(%q) client = %T, want client: %v", tt.cfg.Provider, client, tt.wantClient)
+			}
+			if _, fake := client.(*creditbureau.FakeClient); fake != tt.wantFake {
+				t.Fatalf("NewCreditBureauClient(%q) = %T, want fake: %v", tt.cfg.Provider, client, tt.wantFake)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/creditbureau"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+)
+
+// credit scoring decisions
+const (
+	ScoringDecisionApprove = "approve"
+	ScoringDecisionReview  = "review"
+	ScoringDecisionReject  = "reject"
+)
+
+// credit scoring reason codes, stored comma separated on the scoring_reasons task attribute
+const (
+	ScoringReasonSalaryBelowMin       = "SALARY_BELOW_MIN"
+	ScoringReasonAgeBelowMin          = "AGE_BELOW_MIN"
+	ScoringReasonAgeAboveMax          = "AGE_ABOVE_MAX_AT_MATURITY"
+	ScoringReasonDSRAboveMax          = "DSR_ABOVE_MAX"
+	ScoringReasonDSRHigh              = "DSR_HIGH"
+	ScoringReasonActiveContractsLimit = "ACTIVE_CONTRACTS_LIMIT"
+	ScoringReasonHasActiveContracts   = "HAS_ACTIVE_CONTRACTS"
+	ScoringReasonJobHighRisk          = "JOB_HIGH_RISK"
+	ScoringReasonJobUnknown           = "JOB_UNKNOWN"
+	ScoringReasonScoreBelowMin        = "SCORE_BELOW_MIN"
+	ScoringReasonBureauBad            = "BUREAU_COLLECTIBILITY_BAD"
+	ScoringReasonBureauWatch          = "BUREAU_COLLECTIBILITY_WATCH"
+	ScoringReasonBureauUnavailable    = "BUREAU_UNAVAILABLE"
+	ScoringReasonSubmissionFlagged    = "SUBMISSION_FLAGGED"
+)
+
+// score deductions; a hard-fail rule rejects regardless of the remaining score
+const (
+	scoringMaxScore              = 100
+	scoringPenaltyDSRHigh        = 20
+	scoringPenaltyActiveContract = 10
+	scoringPenaltyJobHighRisk    = 25
+	scoringPenaltyJobUnknown     = 15
+	scoringPenaltyBureauWatch    = 15
+)
+
+// CreditScore is the outcome of the rule-based scoring of one application.
+type CreditScore struct {
+	ContractID       int64        `json:"contract_id"`
+	Score            int          `json:"score"`
+	Decision         string       `json:"decision"`
+	Reasons          []string     `json:"reasons"`
+	DSRPercent       float64      `json:"dsr_percent"`
+	Salary           money.Amount `json:"salary"`
+	CicilanPerBulan  money.Amount `json:"cicilan_per_bulan"`
+	CicilanBerjalan  money.Amount `json:"cicilan_berjalan"`
+	ActiveContracts  int          `json:"active_contracts"`
+	Age              int          `json:"age"`
+	AgeAtMaturity    int          `json:"age_at_maturity"`
+	Pekerjaan        string       `json:"pekerjaan"`
+	Kolektibilitas   *int16       `json:"kolektibilitas"`
+	BureauProvider   string       `json:"bureau_provider,omitempty"`
+	BureauCheckedAt  *time.Time   `json:"bureau_checked_at,omitempty"`
+	ContractApproved bool         `json:"contract_approved"`
+}
+
+// creditApplicant is everything the scoring rules look at.
+type creditApplicant struct {
+	Salary          money.Amount
+	BirthDate       time.Time
+	Pekerjaan       string
+	ActiveContracts int
+	CicilanBerjalan money.Amount
+	CicilanPerBulan money.Amount
+	RequestDate     time.Time
+	TenorBulan      int16
+
+	// BureauRequired is false when no bureau is configured; a required but missing report caps the decision at review.
+	BureauRequired bool
+	Bureau         *models.CreditBureauReport
+	// ManualReview is set by the pre-submission checks and caps the decision at review.
+	ManualReview bool
+}
+
+// scoreApplicant applies the configured rules: hard fails reject, soft rules deduct from 100.
+func scoreApplicant(rules configs.ScoringConfig, applicant creditApplicant) CreditScore {
+	result := CreditScore{
+		Score:           scoringMaxScore,
+		Reasons:         []string{},
+		Salary:          applicant.Salary,
+		CicilanPerBulan: applicant.CicilanPerBulan,
+		CicilanBerjalan: applicant.CicilanBerjalan,
+		ActiveContracts: applicant.ActiveContracts,
+		Age:             ageAt(applicant.BirthDate, applicant.RequestDate),
+		AgeAtMaturity:   ageAt(applicant.BirthDate, applicant.RequestDate.AddDate(0, int(applicant.TenorBulan), 0)),
+		Pekerjaan:       strings.TrimSpace(applicant.Pekerjaan),
+	}
+
+	rejected := false
+	fail := func(reason string) {
+		rejected = true
+		result.Reasons = append(result.Reasons, reason)
+	}
+	deduct := func(reason string, points int) {
+		result.Score -= points
+		result.Reasons = append(result.Reasons, reason)
+	}
+
+	if applicant.Salary.Sign() <= 0 || applicant.Salary < rules.MinSalary {
+		fail(ScoringReasonSalaryBelowMin)
+	}
+	if result.Age < rules.MinAge {
+		fail(ScoringReasonAgeBelowMin)
+	}
+	if rules.MaxAgeAtMaturity > 0 && result.AgeAtMaturity > rules.MaxAgeAtMaturity {
+		fail(ScoringReasonAgeAboveMax)
+	}
+
+	if applicant.Salary.Sign() > 0 {
+		installments := applicant.CicilanBerjalan.Add(applicant.CicilanPerBulan)
+		result.DSRPercent = math.Round(installments.Float64()/applicant.Salary.Float64()*10000) / 100
+	}
+	switch {
+	case applicant.Salary.Sign() <= 0:
+	case result.DSRPercent > rules.MaxDSRPercent:
+		fail(ScoringReasonDSRAboveMax)
+	case result.DSRPercent > rules.ReviewDSRPercent:
+		deduct(ScoringReasonDSRHigh, scoringPenaltyDSRHigh)
+	}
+
+	switch {
+	case applicant.ActiveContracts >= rules.MaxActiveContracts && rules.MaxActiveContracts > 0:
+		fail(ScoringReasonActiveContractsLimit)
+	case applicant.ActiveContracts > 0:
+		deduct(ScoringReasonHasActiveContracts, scoringPenaltyActiveContract*applicant.ActiveContracts)
+	}
+
+	switch {
+	case result.Pekerjaan == "":
+		deduct(ScoringReasonJobUnknown, scoringPenaltyJobUnknown)
+	case isHighRiskJob(rules.HighRiskJobs, result.Pekerjaan):
+		deduct(ScoringReasonJobHighRisk, scoringPenaltyJobHighRisk)
+	}
+
+	needsReview := applicant.ManualReview
+	if applicant.ManualReview {
+		result.Reasons = append(result.Reasons, ScoringReasonSubmissionFlagged)
+	}
+	switch {
+	case applicant.Bureau != nil:
+		grade := applicant.Bureau.Kolektibilitas
+		result.Kolektibilitas = &grade
+		result.BureauProvider = applicant.Bureau.Provider
+		result.BureauCheckedAt = &applicant.Bureau.CheckedAt
+		if int(grade) > rules.MaxKolektibilitas {
+			fail(ScoringReasonBureauBad)
+		} else if grade > creditbureau.KolektibilitasLancar {
+			deduct(ScoringReasonBureauWatch, scoringPenaltyBureauWatch)
+		}
+	case applicant.BureauRequired:
+		needsReview = true
+		result.Reasons = append(result.Reasons, ScoringReasonBureauUnavailable)
+	}
+
+	if result.Score < 0 {
+		result.Score = 0
+	}
+
+	switch {
+	case rejected:
+		result.Decision = ScoringDecisionReject
+	case result.Score >= rules.ApproveScore && !needsReview:
+		result.Decision = ScoringDecisionApprove
+	case result.Score >= rules.ReviewScore:
+		result.Decision = ScoringDecisionReview
+	default:
+		result.Decision = ScoringDecisionReject
+		result.Reasons = append(result.Reasons, ScoringReasonScoreBelowMin)
+	}
+
+	return result
+}
+
+// loadCreditApplicant reads the customer and the installments of their other running contracts.
+func loadCreditApplicant(tx *gorm.DB, contract *models.LeasingContract) (creditApplicant, error) {
+	var customer models.Customer
+	if err := tx.First(&customer, "customer_id = ?", contract.CustomerID).Error; err != nil {
+		return creditApplicant{}, err
+	}
+
+	var running struct {
+		Total   int
+		Cicilan money.Amount
+	}
+	if err := tx.Model(&models.LeasingContract{}).
+		Select("COUNT(*) AS total, COALESCE(SUM(cicilan_per_bulan), 0) AS cicilan").
+		Where("customer_id = ? AND contract_id <> ? AND status IN ?", contract.CustomerID, contract.ContractID,
+			[]string{ContractStatusApproved, ContractStatusActive, ContractStatusLate}).
+		Scan(&running).Error; err != nil {
+		return creditApplicant{}, err
+	}
+
+	return creditApplicant{
+		Salary:          customer.Salary,
+		BirthDate:       customer.TanggalLahir,
+		Pekerjaan:       customer.Pekerjaan,
+		ActiveContracts: running.Total,
+		CicilanBerjalan: running.Cicilan,
+		CicilanPerBulan: contract.CicilanPerBulan,
+		RequestDate:     contract.RequestDate,
+		TenorBulan:      contract.TenorBulan,
+	}, nil
+}
+
+// attributes are the scoring_* task attributes stored on the auto scoring step.
+func (c CreditScore) attributes() []models.LeasingTaskAttribute {
+	attrs := []models.LeasingTaskAttribute{
+		{TasaName: "scoring_score", TasaValue: strconv.Itoa(c.Score)},
+		{TasaName: "scoring_decision", TasaValue: c.Decision},
+		{TasaName: "scoring_dsr_percent", TasaValue: strconv.FormatFloat(c.DSRPercent, 'f', 2, 64)},
+		{TasaName: "scoring_reasons", TasaValue: strings.Join(c.Reasons, ",")},
+	}
+	if c.Kolektibilitas != nil {
+		attrs = append(attrs, models.LeasingTaskAttribute{TasaName: "scoring_kolektibilitas", TasaValue: strconv.Itoa(int(*c.Kolektibilitas))})
+	}
+	return attrs
+}
+
+func isHighRiskJob(jobs []string, pekerjaan string) bool {
+	pekerjaan = strings.ToLower(pekerjaan)
+	for _, job := range jobs {
+		job = strings.ToLower(strings.TrimSpace(job))
+		if job != "" && strings.Contains(pekerjaan, job) {
+			return true
+		}
+	}
+	return false
+}
+
+// ageAt returns full years between birthDate and at.
+func ageAt(birthDate, at time.Time) int {
+	age := at.Year() - birthDate.Year()
+	if at.Month() < birthDate.Month() || (at.Month() == birthDate.Month() && at.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
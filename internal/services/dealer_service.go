@@ -29,6 +29,11 @@ type CustomerService interface {
 	GetByEmail(ctx context.Context, email string) (*models.Customer, error)
 }
 
+type CustomerBlacklistService interface {
+	CRUDService[models.CustomerBlacklist]
+	ListActiveByNIK(ctx context.Context, nik string) ([]models.CustomerBlacklist, error)
+}
+
 type motorTypeService struct {
 	*baseService[models.MotorType]
 	repo repository.MotorTypeRepository
@@ -49,6 +54,11 @@ type customerService struct {
 	repo repository.CustomerRepository
 }
 
+type customerBlacklistService struct {
+	*baseService[models.CustomerBlacklist]
+	repo repository.CustomerBlacklistRepository
+}
+
 func NewMotorTypeService(repo repository.MotorTypeRepository) MotorTypeService {
 	return &motorTypeService{
 		baseService: newBaseService[models.MotorType](repo),
@@ -77,6 +87,13 @@ func NewCustomerService(repo repository.CustomerRepository) CustomerService {
 	}
 }
 
+func NewCustomerBlacklistService(repo repository.CustomerBlacklistRepository) CustomerBlacklistService {
+	return &customerBlacklistService{
+		baseService: newBaseService[models.CustomerBlacklist](repo),
+		repo:        repo,
+	}
+}
+
 func (s *motorTypeService) GetByName(ctx context.Context, name string) (*models.MotorType, error) {
 	return s.repo.GetByName(ctx, name)
 }
@@ -100,3 +117,7 @@ func (s *customerService) GetByNIK(ctx context.Context, nik string) (*models.Cus
 func (s *customerService) GetByEmail(ctx context.Context, email string) (*models.Customer, error) {
 	return s.repo.GetByEmail(ctx, email)
 }
+
+func (s *customerBlacklistService) ListActiveByNIK(ctx context.Context, nik string) ([]models.CustomerBlacklist, error) {
+	return s.repo.ListActiveByNIK(ctx, nik)
+}
@@ -0,0 +1,477 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/storage"
+	"gorm.io/gorm"
+)
+
+// sniffLength is how much of the content http.DetectContentType looks at.
+const sniffLength = 512
+
+// storageSigningKeyPlaceholder is the value shipped in old sample configs; it is public and never accepted.
+const storageSigningKeyPlaceholder = "your-storage-signing-key"
+
+// contract document types (leasing_contract_documents.doc_type)
+const (
+	DocumentTypeKTP   = "ktp"
+	DocumentTypeKK    = "kk"
+	DocumentTypeAkad  = "akad"
+	DocumentTypeUnit  = "unit"
+	DocumentTypeOther = "other"
+)
+
+var documentTypes = map[string]bool{
+	DocumentTypeKTP:   true,
+	DocumentTypeKK:    true,
+	DocumentTypeAkad:  true,
+	DocumentTypeUnit:  true,
+	DocumentTypeOther: true,
+}
+
+// extensions stored in file_type for sniffed MIME types; the table CHECK only allows these.
+var uploadExtensions = map[string]string{
+	"image/jpeg":      "jpg",
+	"image/png":       "png",
+	"application/pdf": "pdf",
+}
+
+// UploadInput is one uploaded file for the contract or motor identified by OwnerID.
+// DocType only applies to contract documents and defaults to other.
+type UploadInput struct {
+	OwnerID  int64
+	DocType  string
+	FileName string
+	Content  io.Reader
+}
+
+// StoredFile is an uploaded file ready to be streamed back from Body. Documents that may only be read
+// through signed URLs carry RedirectURL instead.
+type StoredFile struct {
+	Body        io.ReadCloser
+	Size        int64
+	FileName    string
+	ContentType string
+	Checksum    string
+	RedirectURL string
+}
+
+// SignedURL is a short-lived URL that reads a stored file without the bearer token.
+type SignedURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FileService stores contract documents and motor assets through the configured storage backend.
+type FileService interface {
+	UploadContractDocument(ctx context.Context, input UploadInput) (*models.LeasingContractDocument, error)
+	UploadMotorAsset(ctx context.Context, input UploadInput) (*models.MotorAsset, error)
+	ContractDocumentFile(ctx context.Context, id int64) (*StoredFile, error)
+	ContractDocumentSignedURL(ctx context.Context, id int64) (*SignedURL, error)
+	MotorAssetFile(ctx context.Context, id int64) (*StoredFile, error)
+	// SignedFile serves a signed URL issued by a backend that is read back through this API.
+	SignedFile(ctx context.Context, key, expires, signature string) (*StoredFile, error)
+	// UploadLimit is the global STORAGE.MAX_FILE_SIZE, used to cap request bodies.
+	UploadLimit() int64
+}
+
+// NewFileStorage builds the backend selected by STORAGE.PROVIDER; local and memory sign URLs under PUBLIC_URL.
+// An unknown provider or a missing/placeholder SIGNING_KEY is a startup error: falling back to local disk
+// would silently keep documents on ephemeral storage, and a public key would let anyone forge signed URLs.
+func NewFileStorage(cfg configs.StorageConfig) (storage.Storage, error) {
+	signingKey := strings.TrimSpace(cfg.SigningKey)
+	if signingKey == "" || signingKey == storageSigningKeyPlaceholder {
+		return nil, fmt.Errorf("storage: STORAGE.SIGNING_KEY must be set to a private value")
+	}
+	signer := storage.NewURLSigner(cfg.PublicURL, signingKey)
+
+	switch provider := strings.ToLower(strings.TrimSpace(cfg.Provider)); provider {
+	case storage.ProviderS3:
+		if strings.TrimSpace(cfg.S3.Endpoint) == "" || strings.TrimSpace(cfg.S3.Bucket) == "" {
+			return nil, fmt.Errorf("storage: STORAGE.S3.ENDPOINT and STORAGE.S3.BUCKET are required for provider %q", provider)
+		}
+		return storage.NewS3(storage.S3Options{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+			PathStyle: cfg.S3.UsePathStyle,
+			Timeout:   time.Duration(cfg.S3.TimeoutSeconds) * time.Second,
+		}), nil
+	case storage.ProviderMemory:
+		return storage.NewMemory(signer), nil
+	case storage.ProviderLocal:
+		return storage.NewLocal(cfg.UploadPath, signer), nil
+	}
+
+	return nil, fmt.Errorf("storage: unknown STORAGE.PROVIDER %q (use local, s3 or memory)", cfg.Provider)
+}
+
+type fileService struct {
+	db       *gorm.DB
+	storage  storage.Storage
+	cfg      configs.StorageConfig
+	basePath string
+}
+
+// NewFileService builds download URLs under basePath (SERVER.BASE_PATH).
+func NewFileService(db *gorm.DB, store storage.Storage, cfg configs.StorageConfig, basePath string) FileService {
+	return &fileService{db: db, storage: store, cfg: cfg, basePath: strings.TrimRight(basePath, "/")}
+}
+
+func (s *fileService) UploadLimit() int64 {
+	return s.cfg.MaxFileSize
+}
+
+func (s *fileService) UploadContractDocument(ctx context.Context, input UploadInput) (*models.LeasingContractDocument, error) {
+	if input.OwnerID < 1 || input.Content == nil {
+		return nil, errs.ErrInvalidInput
+	}
+	docType := strings.ToLower(strings.TrimSpace(input.DocType))
+	if docType == "" {
+		docType = DocumentTypeOther
+	}
+	if !documentTypes[docType] {
+		return nil, errs.ErrInvalidInput
+	}
+
+	db := s.db.WithContext(ctx)
+	var contract models.LeasingContract
+	if err := db.Select("contract_id").First(&contract, "contract_id = ?", input.OwnerID).Error; err != nil {
+		return nil, err
+	}
+
+	saved, err := s.store(ctx, s.cfg.ContractDocuments, "contract_documents", input)
+	if err != nil {
+		return nil, err
+	}
+
+	document := models.LeasingContractDocument{
+		FileName:    saved.fileName,
+		FileSize:    float64(saved.size),
+		FileType:    saved.ext,
+		DocType:     docType,
+		StoragePath: &saved.key,
+		Checksum:    &saved.checksum,
+		ContractID:  contract.ContractID,
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&document).Error; err != nil {
+			return err
+		}
+		document.FileURL = s.downloadURL("/leasing/leasing_contract_documents", document.LocID)
+		return tx.Model(&document).Update("file_url", document.FileURL).Error
+	})
+	if err != nil {
+		s.discard(saved.key)
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (s *fileService) UploadMotorAsset(ctx context.Context, input UploadInput) (*models.MotorAsset, error) {
+	if input.OwnerID < 1 || input.Content == nil {
+		return nil, errs.ErrInvalidInput
+	}
+
+	db := s.db.WithContext(ctx)
+	var motor models.Motor
+	if err := db.Select("motor_id").First(&motor, "motor_id = ?", input.OwnerID).Error; err != nil {
+		return nil, err
+	}
+
+	saved, err := s.store(ctx, s.cfg.MotorAssets, "motor_assets", input)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := models.MotorAsset{
+		FileName:    saved.fileName,
+		FileSize:    float64(saved.size),
+		FileType:    saved.ext,
+		StoragePath: &saved.key,
+		Checksum:    &saved.checksum,
+		MoasMotorID: motor.MotorID,
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&asset).Error; err != nil {
+			return err
+		}
+		asset.FileURL = s.downloadURL("/dealer/motor_assets", asset.MoasID)
+		return tx.Model(&asset).Update("file_url", asset.FileURL).Error
+	})
+	if err != nil {
+		s.discard(saved.key)
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// ContractDocumentFile streams the document, except types in SIGNED_DOCUMENT_TYPES (KTP, KK) which are
+// only handed out as a redirect to a short-lived signed URL.
+func (s *fileService) ContractDocumentFile(ctx context.Context, id int64) (*StoredFile, error) {
+	var document models.LeasingContractDocument
+	if err := s.db.WithContext(ctx).First(&document, "loc_id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	if s.requiresSignedURL(document.DocType) {
+		signed, err := s.signedURL(ctx, document.StoragePath)
+		if err != nil {
+			return nil, err
+		}
+		return &StoredFile{FileName: document.FileName, RedirectURL: signed.URL}, nil
+	}
+	return s.open(ctx, document.StoragePath, document.FileName, document.Checksum)
+}
+
+func (s *fileService) ContractDocumentSignedURL(ctx context.Context, id int64) (*SignedURL, error) {
+	var document models.LeasingContractDocument
+	if err := s.db.WithContext(ctx).Select("loc_id", "storage_path").First(&document, "loc_id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return s.signedURL(ctx, document.StoragePath)
+}
+
+func (s *fileService) MotorAssetFile(ctx context.Context, id int64) (*StoredFile, error) {
+	var asset models.MotorAsset
+	if err := s.db.WithContext(ctx).First(&asset, "moas_id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return s.open(ctx, asset.StoragePath, asset.FileName, asset.Checksum)
+}
+
+func (s *fileService) SignedFile(ctx context.Context, key, expires, signature string) (*StoredFile, error) {
+	verifier, ok := s.storage.(storage.SignedURLVerifier)
+	if !ok {
+		return nil, errs.ErrFileMissing
+	}
+	if err := verifier.VerifySignedURL(key, expires, signature); err != nil {
+		return nil, errs.ErrSignedURLInvalid
+	}
+	return s.open(ctx, &key, path.Base(key), nil)
+}
+
+// savedFile describes a file written by store; key is the storage key stored in storage_path.
+type savedFile struct {
+	key      string
+	fileName string
+	ext      string
+	size     int64
+	checksum string
+}
+
+// store sniffs the content type from the first bytes, enforces the size limit and hashes the content
+// with SHA-256 into a temporary file before handing it to the storage backend.
+func (s *fileService) store(ctx context.Context, rules configs.StorageSubdirConfig, fallbackSubdir string, input UploadInput) (*savedFile, error) {
+	maxSize := s.maxSize(rules)
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(input.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+	if n == 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	contentType := normalizeMIME(http.DetectContentType(head))
+	if !mimeAllowed(rules.AllowedTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", errs.ErrFileTypeNotAllowed, contentType)
+	}
+	ext, ok := uploadExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrFileTypeNotAllowed, contentType)
+	}
+
+	tmp, err := os.CreateTemp("", "upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	content := io.MultiReader(bytes.NewReader(head), input.Content)
+	if maxSize > 0 {
+		content = io.LimitReader(content, maxSize+1)
+	}
+	size, err := io.Copy(io.MultiWriter(tmp, hash), content)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && size > maxSize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", errs.ErrFileTooLarge, maxSize)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	subdir := strings.Trim(rules.Subdirectory, "/")
+	if subdir == "" {
+		subdir = fallbackSubdir
+	}
+	name, err := randomFileName(ext)
+	if err != nil {
+		return nil, err
+	}
+	key := path.Join(subdir, strconv.FormatInt(input.OwnerID, 10), name)
+	if err := s.storage.Put(ctx, key, tmp, size, contentType); err != nil {
+		return nil, err
+	}
+
+	return &savedFile{
+		key:      key,
+		fileName: uploadFileName(input.FileName, ext),
+		ext:      ext,
+		size:     size,
+		checksum: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func (s *fileService) open(ctx context.Context, storagePath *string, fileName string, checksum *string) (*StoredFile, error) {
+	if storagePath == nil || strings.TrimSpace(*storagePath) == "" {
+		return nil, errs.ErrFileMissing
+	}
+
+	body, object, err := s.storage.Get(ctx, *storagePath)
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		return nil, errs.ErrFileMissing
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file := &StoredFile{
+		Body:        body,
+		Size:        object.Size,
+		FileName:    fileName,
+		ContentType: object.ContentType,
+	}
+	if file.ContentType == "" {
+		file.ContentType = mime.TypeByExtension(path.Ext(*storagePath))
+	}
+	if checksum != nil {
+		file.Checksum = *checksum
+	}
+	return file, nil
+}
+
+func (s *fileService) signedURL(ctx context.Context, storagePath *string) (*SignedURL, error) {
+	if storagePath == nil || strings.TrimSpace(*storagePath) == "" {
+		return nil, errs.ErrFileMissing
+	}
+
+	ttl := time.Duration(s.cfg.SignedURLTTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+	expiresAt := time.Now().Add(ttl)
+	signed, err := s.storage.SignedURL(ctx, *storagePath, ttl)
+	if errors.Is(err, storage.ErrInvalidKey) {
+		return nil, errs.ErrFileMissing
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SignedURL{URL: signed, ExpiresAt: expiresAt.UTC().Truncate(time.Second)}, nil
+}
+
+func (s *fileService) requiresSignedURL(docType string) bool {
+	for _, signed := range s.cfg.SignedDocumentTypes {
+		if strings.EqualFold(strings.TrimSpace(signed), docType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *fileService) discard(key string) {
+	if err := s.storage.Delete(context.Background(), key); err != nil {
+		log.Printf("storage: failed to remove %s: %v", key, err)
+	}
+}
+
+func (s *fileService) downloadURL(resource string, id int64) string {
+	return fmt.Sprintf("%s%s/%d/download", s.basePath, resource, id)
+}
+
+// maxSize is the smaller non-zero limit of the subdirectory and the global MAX_FILE_SIZE.
+func (s *fileService) maxSize(rules configs.StorageSubdirConfig) int64 {
+	switch {
+	case rules.MaxSize <= 0:
+		return s.cfg.MaxFileSize
+	case s.cfg.MaxFileSize <= 0:
+		return rules.MaxSize
+	}
+	if rules.MaxSize < s.cfg.MaxFileSize {
+		return rules.MaxSize
+	}
+	return s.cfg.MaxFileSize
+}
+
+func normalizeMIME(contentType string) string {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = strings.TrimSpace(contentType[:i])
+	}
+	if contentType == "image/jpg" {
+		return "image/jpeg"
+	}
+	return contentType
+}
+
+func mimeAllowed(allowed []string, contentType string) bool {
+	for _, candidate := range allowed {
+		if normalizeMIME(candidate) == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// uploadFileName keeps the client's base name for display only, with the sniffed extension.
+func uploadFileName(original, ext string) string {
+	name := strings.TrimSpace(filepath.Base(strings.ReplaceAll(original, "\\", "/")))
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	if name == "" || name == "." || name == "/" {
+		name = "file"
+	}
+	const maxBase = 110 // file_name is 125 characters
+	if len(name) > maxBase {
+		name = name[:maxBase]
+	}
+	return name + "." + ext
+}
+
+func randomFileName(ext string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf) + "." + ext, nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"math"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
+
+// interest methods configured on leasing.leasing_product.metode_bunga
+const (
+	InterestMethodFlat      = "flat"
+	InterestMethodEffective = "effective"
+)
+
+// InstallmentSplit is the pokok/margin part of one installment.
+type InstallmentSplit struct {
+	Pokok  money.Amount
+	Margin money.Amount
+}
+
+// InterestCalculator prices a loan and splits it into installments for one interest method.
+// Split must return rows that sum exactly to pokokPinjaman and totalPinjaman-pokokPinjaman.
+type InterestCalculator interface {
+	Method() string
+	TotalPinjaman(pokokPinjaman money.Amount, tenorBulan int16) money.Amount
+	Split(pokokPinjaman, totalPinjaman money.Amount, tenorBulan int16) []InstallmentSplit
+}
+
+// interestCalculators builds the calculator of each metode_bunga from the product rates.
+var interestCalculators = map[string]func(product *models.LeasingProduct) InterestCalculator{
+	InterestMethodFlat: func(product *models.LeasingProduct) InterestCalculator {
+		return flatInterest{rate: product.BungaFlat}
+	},
+	InterestMethodEffective: func(product *models.LeasingProduct) InterestCalculator {
+		return annuityInterest{rate: product.BungaEfektif}
+	},
+}
+
+// interestCalculatorFor picks the product calculator; an empty method is treated as flat.
+func interestCalculatorFor(product *models.LeasingProduct) (InterestCalculator, error) {
+	method := product.MetodeBunga
+	if method == "" {
+		method = InterestMethodFlat
+	}
+	build, ok := interestCalculators[method]
+	if !ok {
+		return nil, errs.ErrInvalidInput
+	}
+	return build(product), nil
+}
+
+// contractInterestCalculator prices with the interest terms frozen on the contract, so editing the product
+// never changes a submitted contract.
+func contractInterestCalculator(contract *models.LeasingContract) (InterestCalculator, error) {
+	return interestCalculatorFor(&models.LeasingProduct{
+		MetodeBunga:  contract.MetodeBunga,
+		BungaFlat:    contract.BungaFlat,
+		BungaEfektif: contract.BungaEfektif,
+	})
+}
+
+// applyInterestTerms copies the product interest terms onto the contract.
+func applyInterestTerms(contract *models.LeasingContract, product *models.LeasingProduct) {
+	contract.MetodeBunga = product.MetodeBunga
+	if contract.MetodeBunga == "" {
+		contract.MetodeBunga = InterestMethodFlat
+	}
+	contract.BungaFlat = product.BungaFlat
+	contract.BungaEfektif = product.BungaEfektif
+}
+
+// flatInterest charges rate percent per year on the original pokok and spreads pokok and margin evenly.
+type flatInterest struct {
+	rate float64
+}
+
+func (flatInterest) Method() string { return InterestMethodFlat }
+
+func (f flatInterest) TotalPinjaman(pokokPinjaman money.Amount, tenorBulan int16) money.Amount {
+	if tenorBulan <= 0 {
+		return pokokPinjaman
+	}
+	margin := pokokPinjaman.MulRateFrac(f.rate, int64(tenorBulan), 12)
+	return pokokPinjaman.Add(margin)
+}
+
+// Split lets the last installment absorb the rounding difference.
+func (flatInterest) Split(pokokPinjaman, totalPinjaman money.Amount, tenorBulan int16) []InstallmentSplit {
+	tenor := int(tenorBulan)
+	if tenor <= 0 {
+		return nil
+	}
+
+	totalMargin := totalPinjaman.Sub(pokokPinjaman)
+	pokokPerBulan := pokokPinjaman.Div(int64(tenor))
+	marginPerBulan := totalMargin.Div(int64(tenor))
+
+	rows := make([]InstallmentSplit, 0, tenor)
+	var allocatedPokok, allocatedMargin money.Amount
+	for i := 1; i <= tenor; i++ {
+		row := InstallmentSplit{Pokok: pokokPerBulan, Margin: marginPerBulan}
+		if i == tenor {
+			row.Pokok = pokokPinjaman.Sub(allocatedPokok)
+			row.Margin = totalMargin.Sub(allocatedMargin)
+		}
+		allocatedPokok = allocatedPokok.Add(row.Pokok)
+		allocatedMargin = allocatedMargin.Add(row.Margin)
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// annuityInterest charges rate percent per year (rate/12 per month) on the declining balance
+// with an equal installment every month.
+type annuityInterest struct {
+	rate float64
+}
+
+func (annuityInterest) Method() string { return InterestMethodEffective }
+
+func (a annuityInterest) TotalPinjaman(pokokPinjaman money.Amount, tenorBulan int16) money.Amount {
+	total := pokokPinjaman
+	for _, row := range a.amortize(pokokPinjaman, tenorBulan) {
+		total = total.Add(row.Margin)
+	}
+	return total
+}
+
+// Split re-runs the amortization and lets the last installment absorb any gap to the stored total.
+func (a annuityInterest) Split(pokokPinjaman, totalPinjaman money.Amount, tenorBulan int16) []InstallmentSplit {
+	rows := a.amortize(pokokPinjaman, tenorBulan)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var allocatedMargin money.Amount
+	for i := 0; i < len(rows)-1; i++ {
+		allocatedMargin = allocatedMargin.Add(rows[i].Margin)
+	}
+	rows[len(rows)-1].Margin = totalPinjaman.Sub(pokokPinjaman).Sub(allocatedMargin)
+	return rows
+}
+
+// amortize builds the annuity rows with a fixed payment rounded to rupiah; the last row clears the balance.
+func (a annuityInterest) amortize(pokokPinjaman money.Amount, tenorBulan int16) []InstallmentSplit {
+	tenor := int(tenorBulan)
+	if tenor <= 0 {
+		return nil
+	}
+
+	balance := pokokPinjaman
+	payment := money.FromFloat(annuityPayment(balance.Float64(), a.rate/1200, tenor)).Round()
+
+	rows := make([]InstallmentSplit, 0, tenor)
+	for i := 1; i <= tenor; i++ {
+		margin := balance.MulRateFrac(a.rate, 1, 12)
+		pokok := payment.Sub(margin)
+		if i == tenor || pokok > balance {
+			pokok = balance
+		}
+		balance = balance.Sub(pokok)
+		rows = append(rows, InstallmentSplit{Pokok: pokok, Margin: margin})
+	}
+	return rows
+}
+
+func annuityPayment(pokok, monthlyRate float64, tenor int) float64 {
+	if monthlyRate == 0 {
+		return pokok / float64(tenor)
+	}
+	return pokok * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(tenor)))
+}
+
+// flatToEffectiveRate is the annual declining-balance rate whose annuity equals the flat installment.
+func flatToEffectiveRate(bungaFlat float64, tenorBulan int16) float64 {
+	if tenorBulan <= 0 || bungaFlat <= 0 {
+		return 0
+	}
+
+	tenor := int(tenorBulan)
+	installment := (1 + bungaFlat/100*float64(tenor)/12) / float64(tenor)
+
+	// bisection on the monthly rate; annuityPayment grows monotonically with the rate
+	low, high := 0.0, 1.0
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if annuityPayment(1, mid, tenor) < installment {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return roundRate((low + high) / 2 * 1200)
+}
+
+// effectiveToFlatRate is the flat annual rate that yields the same total margin as the annuity.
+func effectiveToFlatRate(bungaEfektif float64, tenorBulan int16) float64 {
+	if tenorBulan <= 0 || bungaEfektif <= 0 {
+		return 0
+	}
+
+	tenor := int(tenorBulan)
+	totalMargin := annuityPayment(1, bungaEfektif/1200, tenor)*float64(tenor) - 1
+	return roundRate(totalMargin / (float64(tenor) / 12) * 100)
+}
+
+func roundRate(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
+
+func TestInterestCalculatorFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		wantMethod string
+		wantErr    error
+	}{
+		{name: "empty defaults to flat", method: "", wantMethod: InterestMethodFlat},
+		{name: "flat", method: InterestMethodFlat, wantMethod: InterestMethodFlat},
+		{name: "effective", method: InterestMethodEffective, wantMethod: InterestMethodEffective},
+		{name: "unknown", method: "balloon", wantErr: errs.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator, err := interestCalculatorFor(&models.LeasingProduct{MetodeBunga: tt.method})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("interestCalculatorFor(%q) error = %v, want %v", tt.method, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("interestCalculatorFor(%q) error: %v", tt.method, err)
+			}
+			if got := calculator.Method(); got != tt.wantMethod {
+				t.Fatalf("Method() = %q, want %q", got, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestFlatInterestSchedule(t *testing.T) {
+	tests := []struct {
+		name              string
+		rate              float64
+		pokok             money.Amount
+		tenor             int16
+		wantTotal         money.Amount
+		wantPokokPerBulan money.Amount
+		wantLastPokok     money.Amount
+		wantLastMargin    money.Amount
+	}{
+		{
+			name:              "even split",
+			rate:              12,
+			pokok:             money.Rupiah(12000000),
+			tenor:             12,
+			wantTotal:         money.Rupiah(13440000),
+			wantPokokPerBulan: money.Rupiah(1000000),
+			wantLastPokok:     money.Rupiah(1000000),
+			wantLastMargin:    money.Rupiah(120000),
+		},
+		{
+			name:              "last installment absorbs remainder",
+			rate:              10,
+			pokok:             money.Rupiah(1000000),
+			tenor:             7,
+			wantTotal:         money.Rupiah(1058333),
+			wantPokokPerBulan: money.Rupiah(142857),
+			wantLastPokok:     money.Rupiah(142858),
+			wantLastMargin:    money.Rupiah(8335),
+		},
+		{
+			name:              "sen pokok absorbed by last installment",
+			rate:              6.5,
+			pokok:             money.Amount(1900000050),
+			tenor:             24,
+			wantTotal:         money.Amount(2147000050),
+			wantPokokPerBulan: money.Rupiah(791667),
+			wantLastPokok:     money.Amount(79165950),
+			wantLastMargin:    money.Rupiah(102909),
+		},
+		{
+			name:              "zero rate",
+			rate:              0,
+			pokok:             money.Rupiah(1000000),
+			tenor:             3,
+			wantTotal:         money.Rupiah(1000000),
+			wantPokokPerBulan: money.Rupiah(333333),
+			wantLastPokok:     money.Rupiah(333334),
+			wantLastMargin:    money.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator := flatInterest{rate: tt.rate}
+			total := calculator.TotalPinjaman(tt.pokok, tt.tenor)
+			if total != tt.wantTotal {
+				t.Fatalf("TotalPinjaman = %s, want %s", total, tt.wantTotal)
+			}
+
+			rows := calculator.Split(tt.pokok, total, tt.tenor)
+			assertScheduleReconciles(t, rows, tt.pokok, total, tt.tenor)
+
+			for i, row := range rows[:len(rows)-1] {
+				if row.Pokok != tt.wantPokokPerBulan {
+					t.Fatalf("row %d pokok = %s, want %s", i+1, row.Pokok, tt.wantPokokPerBulan)
+				}
+			}
+			last := rows[len(rows)-1]
+			if last.Pokok != tt.wantLastPokok || last.Margin != tt.wantLastMargin {
+				t.Fatalf("last row = %s/%s, want %s/%s", last.Pokok, last.Margin, tt.wantLastPokok, tt.wantLastMargin)
+			}
+		})
+	}
+}
+
+func TestAnnuityInterestSchedule(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		pokok money.Amount
+		tenor int16
+	}{
+		{name: "one year", rate: 12, pokok: money.Rupiah(12000000), tenor: 12},
+		{name: "odd tenor", rate: 18.5, pokok: money.Rupiah(1000000), tenor: 7},
+		{name: "sen pokok", rate: 11.75, pokok: money.Amount(1900000050), tenor: 24},
+		{name: "long tenor", rate: 24, pokok: money.Rupiah(35000000), tenor: 36},
+		{name: "zero rate", rate: 0, pokok: money.Rupiah(1000000), tenor: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator := annuityInterest{rate: tt.rate}
+			total := calculator.TotalPinjaman(tt.pokok, tt.tenor)
+			rows := calculator.Split(tt.pokok, total, tt.tenor)
+			assertScheduleReconciles(t, rows, tt.pokok, total, tt.tenor)
+
+			// every installment but the last is the same rounded annuity payment
+			payment := rows[0].Pokok.Add(rows[0].Margin)
+			for i, row := range rows[:len(rows)-1] {
+				if got := row.Pokok.Add(row.Margin); got != payment {
+					t.Fatalf("row %d installment = %s, want %s", i+1, got, payment)
+				}
+			}
+		})
+	}
+}
+
+// TestAnnuityInterestSplitAbsorbsStoredTotal checks that a stored total differing from the recomputed
+// amortization is reconciled on the last installment only.
+func TestAnnuityInterestSplitAbsorbsStoredTotal(t *testing.T) {
+	calculator := annuityInterest{rate: 12}
+	pokok := money.Rupiah(12000000)
+	tenor := int16(12)
+
+	expected := calculator.amortize(pokok, tenor)
+	storedTotal := calculator.TotalPinjaman(pokok, tenor).Add(money.Amount(7))
+
+	rows := calculator.Split(pokok, storedTotal, tenor)
+	assertScheduleReconciles(t, rows, pokok, storedTotal, tenor)
+
+	for i := 0; i < len(rows)-1; i++ {
+		if rows[i] != expected[i] {
+			t.Fatalf("row %d = %+v, want %+v", i+1, rows[i], expected[i])
+		}
+	}
+	last := rows[len(rows)-1]
+	if want := expected[len(expected)-1].Margin.Add(money.Amount(7)); last.Margin != want {
+		t.Fatalf("last margin = %s, want %s", last.Margin, want)
+	}
+}
+
+func TestInterestSplitWithoutTenor(t *testing.T) {
+	calculators := []InterestCalculator{flatInterest{rate: 10}, annuityInterest{rate: 10}}
+	for _, calculator := range calculators {
+		t.Run(calculator.Method(), func(t *testing.T) {
+			pokok := money.Rupiah(1000000)
+			if total := calculator.TotalPinjaman(pokok, 0); total != pokok {
+				t.Fatalf("TotalPinjaman with tenor 0 = %s, want %s", total, pokok)
+			}
+			if rows := calculator.Split(pokok, pokok, 0); rows != nil {
+				t.Fatalf("Split with tenor 0 = %v, want nil", rows)
+			}
+		})
+	}
+}
+
+func assertScheduleReconciles(t *testing.T, rows []InstallmentSplit, pokok, total money.Amount, tenor int16) {
+	t.Helper()
+
+	if len(rows) != int(tenor) {
+		t.Fatalf("got %d rows, want %d", len(rows), tenor)
+	}
+
+	var sumPokok, sumTagihan money.Amount
+	for _, row := range rows {
+		if row.Pokok.Sign() < 0 || row.Margin.Sign() < 0 {
+			t.Fatalf("negative installment part %+v", row)
+		}
+		sumPokok = sumPokok.Add(row.Pokok)
+		sumTagihan = sumTagihan.Add(row.Pokok).Add(row.Margin)
+	}
+	if sumPokok != pokok {
+		t.Fatalf("pokok rows sum to %s, want %s", sumPokok, pokok)
+	}
+	if sumTagihan != total {
+		t.Fatalf("installments sum to %s, want %s", sumTagihan, total)
+	}
+}
@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// margin rebate policies for early payoff, see configs.PayoffConfig
+const (
+	RebatePolicyNone     = "none"
+	RebatePolicyFull     = "full"
+	RebatePolicyPercent  = "percent"
+	RebatePolicyRuleOf78 = "rule_of_78"
+)
+
+const (
+	AllocationKindRebate = "rebate"
+	AllocationKindFee    = "fee"
+)
+
+const defaultPayoffQuoteValidDays = 7
+
+type PayoffQuote struct {
+	QuoteID               int64        `json:"quote_id"`
+	ContractID            int64        `json:"contract_id"`
+	QuoteDate             time.Time    `json:"quote_date"`
+	ValidUntil            time.Time    `json:"valid_until"`
+	RebatePolicy          string       `json:"rebate_policy"`
+	RemainingInstallments int          `json:"remaining_installments"`
+	RemainingPrincipal    money.Amount `json:"remaining_principal"`
+	OutstandingMargin     money.Amount `json:"outstanding_margin"`
+	UnearnedMargin        money.Amount `json:"unearned_margin"`
+	MarginRebate          money.Amount `json:"margin_rebate"`
+	Penalties             money.Amount `json:"penalties"`
+	Fee                   money.Amount `json:"fee"`
+	CreditBalance         money.Amount `json:"credit_balance"`
+	PayoffAmount          money.Amount `json:"payoff_amount"`
+}
+
+type PayoffSettlement struct {
+	Quote         PayoffQuote                `json:"quote"`
+	Payment       models.Payment             `json:"payment"`
+	Allocations   []models.PaymentAllocation `json:"allocations"`
+	CreditBalance money.Amount               `json:"credit_balance"`
+	ContractState string                     `json:"contract_status"`
+	BPKBTaskID    int64                      `json:"bpkb_task_id"`
+}
+
+// payoffLine is one open installment as it would be settled by the payoff.
+type payoffLine struct {
+	schedule    *models.PaymentSchedule
+	tagihanSisa money.Amount
+	denda       money.Amount
+	dendaSisa   money.Amount
+}
+
+// gross is what the payoff settles before held contract credit is deducted.
+func (q *PayoffQuote) gross() money.Amount {
+	return money.Sum(q.RemainingPrincipal, q.OutstandingMargin, q.MarginRebate.Neg(), q.Penalties, q.Fee)
+}
+
+// PayoffQuote issues and stores a quote. The quote date cannot lie before today, so penalties that have
+// already accrued cannot be priced away by backdating.
+func (s *leasingWorkflowService) PayoffQuote(ctx context.Context, input PayoffQuoteInput) (*PayoffQuote, error) {
+	if input.ContractID < 1 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	now := time.Now().UTC()
+	quoteDate := input.QuoteDate
+	if quoteDate.IsZero() {
+		quoteDate = now
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if time.Date(quoteDate.Year(), quoteDate.Month(), quoteDate.Day(), 0, 0, 0, 0, time.UTC).Before(today) {
+		return nil, errs.ErrInvalidInput
+	}
+
+	db := s.db.WithContext(ctx)
+
+	var contract models.LeasingContract
+	if err := db.First(&contract, "contract_id = ?", input.ContractID).Error; err != nil {
+		return nil, err
+	}
+
+	quote, _, err := s.buildPayoffQuote(db, &contract, quoteDate, false)
+	if err != nil {
+		return nil, err
+	}
+
+	record := payoffQuoteRecord(quote)
+	if err := db.Create(&record).Error; err != nil {
+		return nil, err
+	}
+	quote.QuoteID = record.QuoteID
+	return quote, nil
+}
+
+// SettlePayoff closes the contract early: every open installment is settled by one payment,
+// the contract moves to paid_off and a BPKB release task is opened.
+// The payment settles a stored quote and must be made within its validity; a quote that no longer matches
+// the contract (e.g. a payment was posted after it was issued) is refused.
+func (s *leasingWorkflowService) SettlePayoff(ctx context.Context, input PayoffInput) (*PayoffSettlement, error) {
+	if input.ContractID < 1 || input.QuoteID < 1 || strings.TrimSpace(input.NomorBukti) == "" {
+		return nil, errs.ErrInvalidInput
+	}
+	amount := input.JumlahBayar
+	if amount.Sign() <= 0 {
+		return nil, errs.ErrInvalidPaymentAmount
+	}
+
+	tanggalBayar := input.TanggalBayar
+	if tanggalBayar.IsZero() {
+		tanggalBayar = time.Now()
+	}
+
+	var result PayoffSettlement
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contract, err := s.lockContract(tx, input.ContractID)
+		if err != nil {
+			return err
+		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
+
+		var issued models.PayoffQuote
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&issued, "quote_id = ? AND contract_id = ?", input.QuoteID, contract.ContractID).Error; err != nil {
+			return err
+		}
+		if issued.PaymentID != nil {
+			return errs.ErrPayoffQuoteStale
+		}
+		paidOn := time.Date(tanggalBayar.Year(), tanggalBayar.Month(), tanggalBayar.Day(), 0, 0, 0, 0, time.UTC)
+		if paidOn.Before(issued.QuoteDate) {
+			return errs.ErrInvalidInput
+		}
+		if paidOn.After(issued.ValidUntil) {
+			return errs.ErrPayoffQuoteExpired
+		}
+
+		// the lines are rebuilt at the stored quote date and must price exactly as issued
+		quote, lines, err := s.buildPayoffQuote(tx, contract, issued.QuoteDate, true)
+		if err != nil {
+			return err
+		}
+		if quote.PayoffAmount != issued.PayoffAmount || quote.gross() != payoffQuoteFromRecord(&issued).gross() {
+			return errs.ErrPayoffQuoteStale
+		}
+		quote.QuoteID = issued.QuoteID
+		if amount < quote.PayoffAmount {
+			return errs.ErrPayoffUnderpaid
+		}
+
+		payment := models.Payment{
+			NomorBukti:       strings.TrimSpace(input.NomorBukti),
+			JumlahBayar:      amount,
+			TanggalBayar:     tanggalBayar,
+			MetodePembayaran: strings.TrimSpace(input.MetodePembayaran),
+			Provider:         strings.TrimSpace(input.Provider),
+			ContractID:       contract.ContractID,
+		}
+		if len(lines) > 0 {
+			scheduleID := lines[0].schedule.ScheduleID
+			payment.ScheduleID = &scheduleID
+		}
+		if err := tx.Create(&payment).Error; err != nil {
+			return err
+		}
+
+		allocations := make([]models.PaymentAllocation, 0, 2*len(lines)+3)
+		for _, line := range lines {
+			if line.dendaSisa.Sign() > 0 {
+				allocations = append(allocations, newAllocation(&payment, line.schedule, AllocationKindPenalty, line.dendaSisa))
+			}
+			if line.tagihanSisa.Sign() > 0 {
+				allocations = append(allocations, newAllocation(&payment, line.schedule, AllocationKindInstallment, line.tagihanSisa))
+			}
+
+			if err := tx.Model(&models.PaymentSchedule{}).
+				Where("schedule_id = ?", line.schedule.ScheduleID).
+				Updates(map[string]interface{}{
+					"denda":             line.denda,
+					"denda_terbayar":    line.denda,
+					"terbayar":          line.schedule.TotalTagihan,
+					"status_pembayaran": ScheduleStatusPaid,
+					"tanggal_bayar":     tanggalBayar,
+				}).Error; err != nil {
+				return err
+			}
+		}
+		if quote.MarginRebate.Sign() > 0 {
+			allocations = append(allocations, newAllocation(&payment, nil, AllocationKindRebate, quote.MarginRebate.Neg()))
+		}
+		if quote.Fee.Sign() > 0 {
+			allocations = append(allocations, newAllocation(&payment, nil, AllocationKindFee, quote.Fee))
+		}
+
+		// whatever is left of payment + held credit stays on the contract as credit
+		previousCredit := contract.SaldoKredit
+		remainingCredit := amount.Add(previousCredit).Sub(quote.gross())
+		if creditDelta := remainingCredit.Sub(previousCredit); !creditDelta.IsZero() {
+			allocations = append(allocations, newAllocation(&payment, nil, AllocationKindCredit, creditDelta))
+			if err := tx.Model(&models.LeasingContract{}).
+				Where("contract_id = ?", contract.ContractID).
+				Update("saldo_kredit", remainingCredit).Error; err != nil {
+				return err
+			}
+			contract.SaldoKredit = remainingCredit
+		}
+		if len(allocations) > 0 {
+			if err := tx.Create(&allocations).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&models.PayoffQuote{}).
+			Where("quote_id = ?", issued.QuoteID).
+			Updates(map[string]interface{}{
+				"payment_id": payment.PaymentID,
+				"settled_at": time.Now().UTC(),
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := s.contracts.Transition(tx, contract, ContractStatusPaidOff, contractAudit(actor, "early payoff", input.Note)); err != nil {
+			return err
+		}
+
+		task, err := s.openBPKBReleaseTask(tx, contract.ContractID, tanggalBayar, payment.NomorBukti)
+		if err != nil {
+			return err
+		}
+
+		result = PayoffSettlement{
+			Quote:         *quote,
+			Payment:       payment,
+			Allocations:   allocations,
+			CreditBalance: contract.SaldoKredit,
+			ContractState: contract.Status,
+			BPKBTaskID:    task.TaskID,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// buildPayoffQuote prices the payoff of every open installment as of quoteDate.
+// Installments already due owe their full pokok and margin; the rebate only touches margin not yet earned.
+func (s *leasingWorkflowService) buildPayoffQuote(tx *gorm.DB, contract *models.LeasingContract, quoteDate time.Time, lock bool) (*PayoffQuote, []payoffLine, error) {
+	if contract.Status != ContractStatusActive && contract.Status != ContractStatusLate {
+		return nil, nil, errs.ErrInvalidStatusTransition
+	}
+
+	var product models.LeasingProduct
+	if err := tx.First(&product, "product_id = ?", contract.ProductID).Error; err != nil {
+		return nil, nil, err
+	}
+
+	query := tx.Where("contract_id = ?", contract.ContractID).Order("jatuh_tempo ASC, angsuran_ke ASC")
+	if lock {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var schedules []models.PaymentSchedule
+	if err := query.Find(&schedules).Error; err != nil {
+		return nil, nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, nil, errs.ErrNoPaymentSchedule
+	}
+
+	rule := penaltyRuleFromProduct(&product)
+	quoteDay := time.Date(quoteDate.Year(), quoteDate.Month(), quoteDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	quote := &PayoffQuote{
+		ContractID:    contract.ContractID,
+		QuoteDate:     quoteDay,
+		ValidUntil:    quoteDay.AddDate(0, 0, s.payoffValidDays()),
+		RebatePolicy:  s.payoffRebatePolicy(),
+		CreditBalance: contract.SaldoKredit,
+	}
+
+	var totalMargin money.Amount
+	futureInstallments := 0
+	lines := make([]payoffLine, 0, len(schedules))
+	for i := range schedules {
+		schedule := &schedules[i]
+		totalMargin = totalMargin.Add(schedule.Margin)
+		if schedule.StatusPembayaran == ScheduleStatusPaid {
+			continue
+		}
+
+		tagihanSisa := money.Max(schedule.TotalTagihan.Sub(schedule.Terbayar), money.Zero)
+		// partial payments are split pro rata between pokok and margin
+		pokokSisa := schedule.Pokok
+		if schedule.TotalTagihan.Sign() > 0 && tagihanSisa != schedule.TotalTagihan {
+			pokokSisa = schedule.Pokok.MulFrac(tagihanSisa.Sen(), schedule.TotalTagihan.Sen())
+		}
+		marginSisa := tagihanSisa.Sub(pokokSisa)
+
+		denda := rule.accrue(schedule, quoteDate)
+		dendaSisa := money.Max(denda.Sub(schedule.DendaTerbayar), money.Zero)
+
+		quote.RemainingInstallments++
+		quote.RemainingPrincipal = quote.RemainingPrincipal.Add(pokokSisa)
+		quote.OutstandingMargin = quote.OutstandingMargin.Add(marginSisa)
+		quote.Penalties = quote.Penalties.Add(dendaSisa)
+		dueDay := time.Date(schedule.JatuhTempo.Year(), schedule.JatuhTempo.Month(), schedule.JatuhTempo.Day(), 0, 0, 0, 0, time.UTC)
+		if dueDay.After(quoteDay) {
+			futureInstallments++
+			quote.UnearnedMargin = quote.UnearnedMargin.Add(marginSisa)
+		}
+
+		lines = append(lines, payoffLine{schedule: schedule, tagihanSisa: tagihanSisa, denda: denda, dendaSisa: dendaSisa})
+	}
+
+	quote.MarginRebate = s.marginRebate(quote.UnearnedMargin, totalMargin, futureInstallments, len(schedules))
+	quote.Fee = s.payoffFee(quote.RemainingPrincipal)
+	quote.PayoffAmount = money.Max(quote.gross().Sub(quote.CreditBalance), money.Zero)
+
+	return quote, lines, nil
+}
+
+func payoffQuoteRecord(quote *PayoffQuote) models.PayoffQuote {
+	return models.PayoffQuote{
+		QuoteDate:             quote.QuoteDate,
+		ValidUntil:            quote.ValidUntil,
+		RebatePolicy:          quote.RebatePolicy,
+		RemainingInstallments: quote.RemainingInstallments,
+		RemainingPrincipal:    quote.RemainingPrincipal,
+		OutstandingMargin:     quote.OutstandingMargin,
+		UnearnedMargin:        quote.UnearnedMargin,
+		MarginRebate:          quote.MarginRebate,
+		Penalties:             quote.Penalties,
+		Fee:                   quote.Fee,
+		CreditBalance:         quote.CreditBalance,
+		PayoffAmount:          quote.PayoffAmount,
+		ContractID:            quote.ContractID,
+	}
+}
+
+func payoffQuoteFromRecord(record *models.PayoffQuote) *PayoffQuote {
+	return &PayoffQuote{
+		QuoteID:               record.QuoteID,
+		ContractID:            record.ContractID,
+		QuoteDate:             record.QuoteDate,
+		ValidUntil:            record.ValidUntil,
+		RebatePolicy:          record.RebatePolicy,
+		RemainingInstallments: record.RemainingInstallments,
+		RemainingPrincipal:    record.RemainingPrincipal,
+		OutstandingMargin:     record.OutstandingMargin,
+		UnearnedMargin:        record.UnearnedMargin,
+		MarginRebate:          record.MarginRebate,
+		Penalties:             record.Penalties,
+		Fee:                   record.Fee,
+		CreditBalance:         record.CreditBalance,
+		PayoffAmount:          record.PayoffAmount,
+	}
+}
+
+// marginRebate applies the configured policy; rule_of_78 rebates the sum-of-digits share of the
+// remaining k of n installments, capped at the margin actually unearned.
+func (s *leasingWorkflowService) marginRebate(unearned, totalMargin money.Amount, remaining, tenor int) money.Amount {
+	var rebate money.Amount
+	switch s.payoffRebatePolicy() {
+	case RebatePolicyFull:
+		rebate = unearned
+	case RebatePolicyPercent:
+		rebate = unearned.MulRate(s.payoff.RebatePercent)
+	case RebatePolicyRuleOf78:
+		if tenor > 0 {
+			rebate = totalMargin.MulFrac(int64(remaining*(remaining+1)), int64(tenor*(tenor+1)))
+		}
+	}
+	return money.Min(money.Max(rebate, money.Zero), unearned)
+}
+
+func (s *leasingWorkflowService) payoffFee(remainingPrincipal money.Amount) money.Amount {
+	fee := remainingPrincipal.MulRate(s.payoff.FeePercent)
+	if feeMin := s.payoff.FeeMin.Round(); remainingPrincipal.Sign() > 0 && fee < feeMin {
+		fee = feeMin
+	}
+	return money.Max(fee, money.Zero)
+}
+
+func (s *leasingWorkflowService) payoffRebatePolicy() string {
+	switch policy := strings.TrimSpace(strings.ToLower(s.payoff.RebatePolicy)); policy {
+	case RebatePolicyFull, RebatePolicyPercent, RebatePolicyRuleOf78:
+		return policy
+	default:
+		return RebatePolicyNone
+	}
+}
+
+func (s *leasingWorkflowService) payoffValidDays() int {
+	if s.payoff.QuoteValidDays > 0 {
+		return s.payoff.QuoteValidDays
+	}
+	return defaultPayoffQuoteValidDays
+}
+
+// openBPKBReleaseTask appends the BPKB hand-back step, owned by the same role as the system_closed step.
+func (s *leasingWorkflowService) openBPKBReleaseTask(tx *gorm.DB, contractID int64, startDate time.Time, nomorBukti string) (*models.LeasingTask, error) {
+	closing, err := findWorkflowTasks(tx, contractID, WorkflowStepSystemClosed)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastSequence int
+	if err := tx.Model(&models.LeasingTask{}).
+		Where("contract_id = ?", contractID).
+		Select("COALESCE(MAX(sequence_no), 0)").
+		Scan(&lastSequence).Error; err != nil {
+		return nil, err
+	}
+
+	code := WorkflowStepBPKBRelease
+	task := models.LeasingTask{
+		TaskName:   "Pelepasan BPKB",
+		TaskCode:   &code,
+		StartDate:  startDate,
+		EndDate:    startDate.AddDate(0, 0, 14),
+		SequenceNo: lastSequence + 1,
+		Status:     TaskStatusInProgress,
+		ContractID: contractID,
+		RoleID:     closing[0].RoleID,
+	}
+	if err := tx.Create(&task).Error; err != nil {
+		return nil, err
+	}
+
+	attr := models.LeasingTaskAttribute{
+		TasaName:   "payoff_payment",
+		TasaValue:  nomorBukti,
+		TasaStatus: TaskAttrStatusCompleted,
+		TasaLetaID: task.TaskID,
+	}
+	if err := tx.Create(&attr).Error; err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
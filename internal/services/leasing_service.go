@@ -4,18 +4,36 @@ import (
 	"context"
 
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// scheduleTermColumns are contract columns that change the installment schedule.
+var scheduleTermColumns = []string{
+	"tanggal_mulai_cicil", "dp_dibayar", "tenor_bulan", "nilai_kendaraan", "product_id",
+	"metode_bunga", "bunga_flat", "bunga_efektif", "biaya_dibiayai",
+}
+
+// interestTermColumns are the product interest terms frozen on the contract.
+var interestTermColumns = []string{"metode_bunga", "bunga_flat", "bunga_efektif"}
+
 type LeasingProductService interface {
 	CRUDService[models.LeasingProduct]
 	GetByKodeProduk(ctx context.Context, kodeProduk string) (*models.LeasingProduct, error)
 }
 
+type InsuranceRateService interface {
+	CRUDService[models.InsuranceRate]
+	ListByMotorTypeID(ctx context.Context, motyID int64) ([]models.InsuranceRate, error)
+}
+
 type LeasingContractService interface {
 	CRUDService[models.LeasingContract]
 	GetByContractNumber(ctx context.Context, contractNumber string) (*models.LeasingContract, error)
 	ListByCustomerID(ctx context.Context, customerID int64) ([]models.LeasingContract, error)
+	ListStatusHistory(ctx context.Context, contractID int64) ([]models.ContractStatusHistory, error)
 }
 
 type LeasingTaskService interface {
@@ -38,9 +56,16 @@ type leasingProductService struct {
 	repo repository.LeasingProductRepository
 }
 
+type insuranceRateService struct {
+	*baseService[models.InsuranceRate]
+	repo repository.InsuranceRateRepository
+}
+
 type leasingContractService struct {
 	*baseService[models.LeasingContract]
-	repo repository.LeasingContractRepository
+	repo      repository.LeasingContractRepository
+	db        *gorm.DB
+	contracts *ContractStateMachine
 }
 
 type leasingTaskService struct {
@@ -65,10 +90,19 @@ func NewLeasingProductService(repo repository.LeasingProductRepository) LeasingP
 	}
 }
 
-func NewLeasingContractService(repo repository.LeasingContractRepository) LeasingContractService {
+func NewInsuranceRateService(repo repository.InsuranceRateRepository) InsuranceRateService {
+	return &insuranceRateService{
+		baseService: newBaseService[models.InsuranceRate](repo),
+		repo:        repo,
+	}
+}
+
+func NewLeasingContractService(repo repository.LeasingContractRepository, db *gorm.DB, contracts *ContractStateMachine) LeasingContractService {
 	return &leasingContractService{
 		baseService: newBaseService[models.LeasingContract](repo),
 		repo:        repo,
+		db:          db,
+		contracts:   contracts,
 	}
 }
 
@@ -97,10 +131,122 @@ func (s *leasingProductService) GetByKodeProduk(ctx context.Context, kodeProduk
 	return s.repo.GetByKodeProduk(ctx, kodeProduk)
 }
 
+func (s *insuranceRateService) ListByMotorTypeID(ctx context.Context, motyID int64) ([]models.InsuranceRate, error) {
+	return s.repo.ListByMotorTypeID(ctx, motyID)
+}
+
 func (s *leasingContractService) GetByContractNumber(ctx context.Context, contractNumber string) (*models.LeasingContract, error) {
 	return s.repo.GetByContractNumber(ctx, contractNumber)
 }
 
+// Update keeps an existing payment schedule in sync when start date, DP, tenor or interest terms are edited
+// directly; moving the contract to another product re-copies that product's interest terms.
+// Status changes go through the contract state machine so guards and side effects still apply.
+func (s *leasingContractService) Update(ctx context.Context, id int64, updates map[string]interface{}) error {
+	if id < 1 || len(updates) == 0 {
+		return errs.ErrInvalidInput
+	}
+
+	rawStatus, changesStatus := updates["status"]
+	touchesTerms := touchesAnyColumn(updates, scheduleTermColumns)
+	if !changesStatus && !touchesTerms {
+		return s.repo.Update(ctx, id, updates)
+	}
+
+	nextStatus, ok := rawStatus.(string)
+	if changesStatus && !ok {
+		return errs.ErrInvalidInput
+	}
+
+	fields := make(map[string]interface{}, len(updates))
+	for column, value := range updates {
+		if column != "status" {
+			fields[column] = value
+		}
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var contract models.LeasingContract
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&contract, "contract_id = ?", id).Error; err != nil {
+			return err
+		}
+		if len(fields) > 0 {
+			if err := tx.Model(&models.LeasingContract{}).
+				Where("contract_id = ?", id).
+				Updates(fields).Error; err != nil {
+				return err
+			}
+		}
+		if _, ok := fields["product_id"]; ok && !touchesAnyColumn(fields, interestTermColumns) {
+			if err := snapshotInterestTerms(tx, id); err != nil {
+				return err
+			}
+		}
+		if touchesTerms {
+			if err := refreshPaymentScheduleIfExists(tx, id); err != nil {
+				return err
+			}
+		}
+		if !changesStatus {
+			return nil
+		}
+
+		if err := tx.First(&contract, "contract_id = ?", id).Error; err != nil {
+			return err
+		}
+		actorID, _ := ActorFromContext(ctx)
+		return s.contracts.Transition(tx, &contract, nextStatus, ContractTransitionAudit{
+			ActorID: actorID,
+			Reason:  "status updated via leasing_contract API",
+		})
+	})
+}
+
+// Create records the initial status so the history always starts at the contract creation.
+func (s *leasingContractService) Create(ctx context.Context, entity *models.LeasingContract) error {
+	if entity == nil {
+		return errs.ErrInvalidInput
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if entity.MetodeBunga == "" {
+			var product models.LeasingProduct
+			if err := tx.First(&product, "product_id = ?", entity.ProductID).Error; err != nil {
+				return err
+			}
+			applyInterestTerms(entity, &product)
+		}
+		if err := tx.Create(entity).Error; err != nil {
+			return err
+		}
+		actorID, _ := ActorFromContext(ctx)
+		return recordContractStatus(tx, entity.ContractID, nil, entity.Status, ContractTransitionAudit{
+			ActorID: actorID,
+			Reason:  "created via leasing_contract API",
+		})
+	})
+}
+
+func (s *leasingContractService) ListStatusHistory(ctx context.Context, contractID int64) ([]models.ContractStatusHistory, error) {
+	if contractID < 1 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var contract models.LeasingContract
+	if err := s.db.WithContext(ctx).Select("contract_id").
+		First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return nil, err
+	}
+
+	history := make([]models.ContractStatusHistory, 0)
+	err := s.db.WithContext(ctx).
+		Where("contract_id = ?", contractID).
+		Order("created_at ASC, history_id ASC").
+		Find(&history).Error
+	return history, err
+}
+
 func (s *leasingContractService) ListByCustomerID(ctx context.Context, customerID int64) ([]models.LeasingContract, error) {
 	return s.repo.ListByCustomerID(ctx, customerID)
 }
@@ -116,3 +262,33 @@ func (s *leasingTaskAttributeService) ListByTaskID(ctx context.Context, taskID i
 func (s *leasingContractDocumentService) ListByContractID(ctx context.Context, contractID int64) ([]models.LeasingContractDocument, error) {
 	return s.repo.ListByContractID(ctx, contractID)
 }
+
+// snapshotInterestTerms re-copies the interest terms after the contract moved to another product.
+func snapshotInterestTerms(tx *gorm.DB, contractID int64) error {
+	var contract models.LeasingContract
+	if err := tx.Select("contract_id", "product_id").
+		First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return err
+	}
+	var product models.LeasingProduct
+	if err := tx.First(&product, "product_id = ?", contract.ProductID).Error; err != nil {
+		return err
+	}
+	applyInterestTerms(&contract, &product)
+	return tx.Model(&models.LeasingContract{}).
+		Where("contract_id = ?", contractID).
+		Updates(map[string]interface{}{
+			"metode_bunga":  contract.MetodeBunga,
+			"bunga_flat":    contract.BungaFlat,
+			"bunga_efektif": contract.BungaEfektif,
+		}).Error
+}
+
+func touchesAnyColumn(updates map[string]interface{}, columns []string) bool {
+	for _, column := range columns {
+		if _, ok := updates[column]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+)
+
+// SimulationInput prices a motor without creating a contract. Either MotorID or HargaOTR is required;
+// ProductID 0 simulates every product, DPDibayar 0 uses each product's minimum DP.
+type SimulationInput struct {
+	MotorID           int64
+	HargaOTR          money.Amount
+	ProductID         int64
+	DPDibayar         money.Amount
+	TenorBulan        int16
+	TanggalMulaiCicil *time.Time
+}
+
+type AmortizationRow struct {
+	AngsuranKe   int16        `json:"angsuran_ke"`
+	JatuhTempo   time.Time    `json:"jatuh_tempo"`
+	Pokok        money.Amount `json:"pokok"`
+	Margin       money.Amount `json:"margin"`
+	TotalTagihan money.Amount `json:"total_tagihan"`
+	SisaPokok    money.Amount `json:"sisa_pokok"`
+}
+
+type ProductSimulation struct {
+	ProductID       int64             `json:"product_id"`
+	KodeProduk      string            `json:"kode_produk"`
+	NamaProduk      string            `json:"nama_produk"`
+	TenorBulan      int16             `json:"tenor_bulan"`
+	MetodeBunga     string            `json:"metode_bunga"`
+	BungaFlat       float64           `json:"bunga_flat"`
+	BungaEfektif    float64           `json:"bunga_efektif"`
+	DPMin           money.Amount      `json:"dp_min"`
+	DPMax           money.Amount      `json:"dp_max"`
+	DPDibayar       money.Amount      `json:"dp_dibayar"`
+	DPInRange       bool              `json:"dp_in_range"`
+	PokokPinjaman   money.Amount      `json:"pokok_pinjaman"`
+	TotalPinjaman   money.Amount      `json:"total_pinjaman"`
+	CicilanPerBulan money.Amount      `json:"cicilan_per_bulan"`
+	AdminFee        money.Amount      `json:"admin_fee"`
+	AsuransiPremi   money.Amount      `json:"asuransi_premi"`
+	BiayaFidusia    money.Amount      `json:"biaya_fidusia"`
+	BiayaDibiayai   money.Amount      `json:"biaya_dibiayai"`
+	BiayaDimuka     money.Amount      `json:"biaya_dimuka"`
+	PembayaranAwal  money.Amount      `json:"pembayaran_awal"`
+	Amortization    []AmortizationRow `json:"amortization"`
+}
+
+// UnavailableProduct is a product the all-products view could not price, e.g. no insurance rate for the tenor.
+type UnavailableProduct struct {
+	ProductID  int64  `json:"product_id"`
+	KodeProduk string `json:"kode_produk"`
+	NamaProduk string `json:"nama_produk"`
+	Reason     string `json:"reason"`
+}
+
+type SimulationResult struct {
+	MotorID             *int64               `json:"motor_id,omitempty"`
+	NilaiKendaraan      money.Amount         `json:"nilai_kendaraan"`
+	Products            []ProductSimulation  `json:"products"`
+	UnavailableProducts []UnavailableProduct `json:"unavailable_products"`
+}
+
+// Simulate runs the same pricing and schedule code as SubmitApplication/syncPaymentSchedule, read-only.
+// A single requested product rejects a DP outside its range or a missing insurance rate; the all-products
+// view flags the DP and lists products without an insurance rate under UnavailableProducts instead.
+func (s *leasingWorkflowService) Simulate(ctx context.Context, input SimulationInput) (*SimulationResult, error) {
+	if (input.MotorID < 1 && input.HargaOTR.Sign() <= 0) || input.DPDibayar.Sign() < 0 || input.TenorBulan < 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	db := s.db.WithContext(ctx)
+	result := &SimulationResult{NilaiKendaraan: input.HargaOTR}
+	var motyID *int64
+
+	if input.MotorID > 0 {
+		var motor models.Motor
+		if err := db.First(&motor, "motor_id = ?", input.MotorID).Error; err != nil {
+			return nil, err
+		}
+		motorID := motor.MotorID
+		result.MotorID = &motorID
+		result.NilaiKendaraan = motor.HargaOTR
+		motyID = &motor.MotorMotyID
+	}
+
+	var products []models.LeasingProduct
+	query := db.Order("product_id ASC")
+	if input.ProductID > 0 {
+		query = query.Where("product_id = ?", input.ProductID)
+	}
+	if err := query.Find(&products).Error; err != nil {
+		return nil, err
+	}
+	if input.ProductID > 0 && len(products) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	mulaiCicil := time.Now().UTC().AddDate(0, 1, 0)
+	if input.TanggalMulaiCicil != nil && !input.TanggalMulaiCicil.IsZero() {
+		mulaiCicil = *input.TanggalMulaiCicil
+	}
+
+	result.Products = make([]ProductSimulation, 0, len(products))
+	result.UnavailableProducts = make([]UnavailableProduct, 0)
+	for i := range products {
+		simulation, err := simulateProduct(db, &products[i], result.NilaiKendaraan, motyID, input, mulaiCicil)
+		if input.ProductID == 0 && errors.Is(err, errs.ErrInsuranceRateNotFound) {
+			result.UnavailableProducts = append(result.UnavailableProducts, UnavailableProduct{
+				ProductID:  products[i].ProductID,
+				KodeProduk: products[i].KodeProduk,
+				NamaProduk: products[i].NamaProduk,
+				Reason:     err.Error(),
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if input.ProductID > 0 && !simulation.DPInRange {
+			return nil, errs.ErrDPOutOfRange
+		}
+		result.Products = append(result.Products, *simulation)
+	}
+
+	return result, nil
+}
+
+// simulateProduct prices fees like SubmitApplication; without a motor only the generic insurance rate applies.
+func simulateProduct(db *gorm.DB, product *models.LeasingProduct, nilaiKendaraan money.Amount, motyID *int64, input SimulationInput, mulaiCicil time.Time) (*ProductSimulation, error) {
+	tenor, err := resolveTenor(product, input.TenorBulan)
+	if err != nil {
+		return nil, err
+	}
+
+	minDP, maxDP := dpRange(nilaiKendaraan, product)
+	dp := input.DPDibayar
+	if dp.IsZero() {
+		dp = minDP
+	}
+
+	fees, err := computeContractFees(db, product, motyID, nilaiKendaraan, tenor)
+	if err != nil {
+		return nil, err
+	}
+
+	interest, err := interestCalculatorFor(product)
+	if err != nil {
+		return nil, err
+	}
+
+	// the product rate is shown as-is; the other method shows its equivalent for the same tenor
+	bungaFlat, bungaEfektif := product.BungaFlat, flatToEffectiveRate(product.BungaFlat, tenor)
+	if interest.Method() == InterestMethodEffective {
+		bungaFlat, bungaEfektif = effectiveToFlatRate(product.BungaEfektif, tenor), product.BungaEfektif
+	}
+
+	pricing := priceContract(nilaiKendaraan, dp, fees.Dibiayai, interest, tenor)
+	contract := &models.LeasingContract{
+		TanggalMulaiCicil: mulaiCicil,
+		TenorBulan:        tenor,
+		PokokPinjaman:     pricing.PokokPinjaman,
+		TotalPinjaman:     pricing.TotalPinjaman,
+	}
+	schedule := buildPaymentSchedule(contract, interest)
+	if err := reconcileSchedule(contract, schedule); err != nil {
+		return nil, err
+	}
+
+	amortization := make([]AmortizationRow, 0, len(schedule))
+	sisaPokok := pricing.PokokPinjaman
+	for _, row := range schedule {
+		sisaPokok = sisaPokok.Sub(row.Pokok)
+		amortization = append(amortization, AmortizationRow{
+			AngsuranKe:   row.AngsuranKe,
+			JatuhTempo:   row.JatuhTempo,
+			Pokok:        row.Pokok,
+			Margin:       row.Margin,
+			TotalTagihan: row.TotalTagihan,
+			SisaPokok:    sisaPokok,
+		})
+	}
+
+	return &ProductSimulation{
+		ProductID:       product.ProductID,
+		KodeProduk:      product.KodeProduk,
+		NamaProduk:      product.NamaProduk,
+		TenorBulan:      tenor,
+		MetodeBunga:     interest.Method(),
+		BungaFlat:       bungaFlat,
+		BungaEfektif:    bungaEfektif,
+		DPMin:           minDP,
+		DPMax:           maxDP,
+		DPDibayar:       dp,
+		DPInRange:       dp >= minDP && dp <= maxDP,
+		PokokPinjaman:   pricing.PokokPinjaman,
+		TotalPinjaman:   pricing.TotalPinjaman,
+		CicilanPerBulan: pricing.CicilanPerBulan,
+		AdminFee:        fees.AdminFee,
+		AsuransiPremi:   fees.AsuransiPremi,
+		BiayaFidusia:    fees.BiayaFidusia,
+		BiayaDibiayai:   fees.Dibiayai,
+		BiayaDimuka:     fees.Dimuka,
+		PembayaranAwal:  dp.Add(fees.Dimuka),
+		Amortization:    amortization,
+	}, nil
+}
@@ -2,11 +2,11 @@ package services
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
 	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
 	"gorm.io/gorm"
@@ -16,21 +16,41 @@ import (
 // LeasingWorkflowService contains end-to-end business process from request until delivery.
 type LeasingWorkflowService interface {
 	SubmitApplication(ctx context.Context, input SubmitApplicationInput) (*models.LeasingContract, error)
-	ProcessAutoScoring(ctx context.Context, input AutoScoringDecisionInput) error
+	ProcessAutoScoring(ctx context.Context, input AutoScoringDecisionInput) (*CreditScore, error)
 	ProcessSurveyResult(ctx context.Context, input SurveyDecisionInput) error
 	ProcessFinalApproval(ctx context.Context, input FinalApprovalInput) error
 	ExecuteAkad(ctx context.Context, input AkadInput) error
-	RecordInitialPayment(ctx context.Context, input InitialPaymentInput) error
+	RecordInitialPayment(ctx context.Context, input InitialPaymentInput) (*InitialPaymentResult, error)
 	ProcessDealerFulfillment(ctx context.Context, input DealerFulfillmentInput) error
 	CompleteDelivery(ctx context.Context, input DeliveryCompletionInput) error
+	ContractStateGraph() ContractStateGraph
+	ContractTransitions(ctx context.Context, contractID int64) (*ContractTransitionOptions, error)
+	Simulate(ctx context.Context, input SimulationInput) (*SimulationResult, error)
+	PayoffQuote(ctx context.Context, input PayoffQuoteInput) (*PayoffQuote, error)
+	SettlePayoff(ctx context.Context, input PayoffInput) (*PayoffSettlement, error)
 }
 
 type leasingWorkflowService struct {
-	db *gorm.DB
+	db         *gorm.DB
+	contracts  *ContractStateMachine
+	payoff     configs.PayoffConfig
+	scoring    configs.ScoringConfig
+	submission configs.SubmissionConfig
+	bureau     CreditBureauClient
+
+	bureauCacheDays int
 }
 
-func NewLeasingWorkflowService(db *gorm.DB) LeasingWorkflowService {
-	return &leasingWorkflowService{db: db}
+func NewLeasingWorkflowService(db *gorm.DB, contracts *ContractStateMachine, payoff configs.PayoffConfig, scoring configs.ScoringConfig, submission configs.SubmissionConfig, bureau CreditBureauClient, bureauCacheDays int) LeasingWorkflowService {
+	return &leasingWorkflowService{
+		db:              db,
+		contracts:       contracts,
+		payoff:          payoff,
+		scoring:         scoring,
+		submission:      submission,
+		bureau:          bureau,
+		bureauCacheDays: bureauCacheDays,
+	}
 }
 
 func (s *leasingWorkflowService) SubmitApplication(ctx context.Context, input SubmitApplicationInput) (*models.LeasingContract, error) {
@@ -56,29 +76,42 @@ func (s *leasingWorkflowService) SubmitApplication(ctx context.Context, input Su
 			return errs.ErrMotorUnitNotReady
 		}
 
+		var customer models.Customer
+		if err := tx.First(&customer, "customer_id = ?", input.CustomerID).Error; err != nil {
+			return err
+		}
+		reviewReasons, err := runSubmissionChecks(tx, s.submission, &customer)
+		if err != nil {
+			return err
+		}
+
 		var product models.LeasingProduct
 		if err := tx.First(&product, "product_id = ?", input.ProductID).Error; err != nil {
 			return err
 		}
 
-		tenor := product.TenorBulan
-		if input.TenorBulan > 0 {
-			tenor = input.TenorBulan
-		}
-		if tenor <= 0 {
-			return errs.ErrInvalidInput
+		tenor, err := resolveTenor(&product, input.TenorBulan)
+		if err != nil {
+			return err
 		}
 
 		nilaiKendaraan := motor.HargaOTR
-		minDP := nilaiKendaraan * product.DPPersenMin / 100
-		maxDP := nilaiKendaraan * product.DPPersenMax / 100
+		minDP, maxDP := dpRange(nilaiKendaraan, &product)
 		if input.DPDibayar < minDP || input.DPDibayar > maxDP {
 			return errs.ErrDPOutOfRange
 		}
 
-		pokokPinjaman := nilaiKendaraan - input.DPDibayar
-		totalPinjaman := calculateTotalPinjaman(pokokPinjaman, product.BungaFlat, tenor)
-		cicilan := totalPinjaman / float64(tenor)
+		fees, err := computeContractFees(tx, &product, &motor.MotorMotyID, nilaiKendaraan, tenor)
+		if err != nil {
+			return err
+		}
+
+		interest, err := interestCalculatorFor(&product)
+		if err != nil {
+			return err
+		}
+
+		pricing := priceContract(nilaiKendaraan, input.DPDibayar, fees.Dibiayai, interest, tenor)
 		mulaiCicil := requestDate.AddDate(0, 1, 0)
 
 		contract := models.LeasingContract{
@@ -87,17 +120,30 @@ func (s *leasingWorkflowService) SubmitApplication(ctx context.Context, input Su
 			TenorBulan:        tenor,
 			NilaiKendaraan:    nilaiKendaraan,
 			DPDibayar:         input.DPDibayar,
-			PokokPinjaman:     pokokPinjaman,
-			TotalPinjaman:     totalPinjaman,
-			CicilanPerBulan:   cicilan,
+			PokokPinjaman:     pricing.PokokPinjaman,
+			TotalPinjaman:     pricing.TotalPinjaman,
+			CicilanPerBulan:   pricing.CicilanPerBulan,
 			Status:            ContractStatusDraft,
 			CustomerID:        input.CustomerID,
 			MotorID:           input.MotorID,
 			ProductID:         input.ProductID,
 		}
+		fees.apply(&contract)
+		applyInterestTerms(&contract, &product)
+		if len(reviewReasons) > 0 {
+			reasons := strings.Join(reviewReasons, ",")
+			contract.ManualReview = true
+			contract.ReviewReasons = &reasons
+		}
 		if err := tx.Create(&contract).Error; err != nil {
 			return err
 		}
+		if err := recordContractStatus(tx, contract.ContractID, nil, contract.Status, ContractTransitionAudit{
+			ActorID: input.ActorID,
+			Reason:  "application submitted",
+		}); err != nil {
+			return err
+		}
 
 		if err := tx.Model(&models.Motor{}).
 			Where("motor_id = ?", motor.MotorID).
@@ -135,38 +181,50 @@ func (s *leasingWorkflowService) SubmitApplication(ctx context.Context, input Su
 	return &created, nil
 }
 
-func (s *leasingWorkflowService) ProcessAutoScoring(ctx context.Context, input AutoScoringDecisionInput) error {
+// ProcessAutoScoring returns the credit score when the engine decided (input.Auto), nil otherwise.
+func (s *leasingWorkflowService) ProcessAutoScoring(ctx context.Context, input AutoScoringDecisionInput) (*CreditScore, error) {
 	if input.ContractID < 1 {
-		return errs.ErrInvalidInput
+		return nil, errs.ErrInvalidInput
 	}
 
-	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	var bureauReport *models.CreditBureauReport
+	if input.Auto {
+		report, err := s.lookupCreditBureau(ctx, input.ContractID)
+		if err != nil {
+			return nil, err
+		}
+		bureauReport = report
+	}
+
+	var score *CreditScore
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		contract, err := s.lockContract(tx, input.ContractID)
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusDraft {
 			return errs.ErrContractNotDraft
 		}
 
-		if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "scoring", TaskStatusCompleted); err != nil {
+		if input.Auto {
+			score, err = s.runCreditScoring(tx, actor, contract, bureauReport, input.Note)
 			return err
 		}
 
-		if input.AutoApproved {
-			if err := s.transitionContractStatus(tx, contract, ContractStatusApproved); err != nil {
-				return err
-			}
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "pre-approval", TaskStatusCompleted); err != nil {
-				return err
-			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "scoring", "auto_scoring_note", input.Note, TaskAttrStatusCompleted)
+		// contracts flagged at submission always go through manual review
+		if input.AutoApproved && !contract.ManualReview {
+			return s.approveAutoScoring(tx, actor, contract, "auto scoring approved", input.Note)
 		}
 
-		if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "review", TaskStatusInProgress); err != nil {
+		// manual review keeps the scoring step open until the reviewer decides
+		if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusInProgress, WorkflowStepAutoScoring); err != nil {
 			return err
 		}
-		if err := s.appendTaskNoteByKeyword(tx, contract.ContractID, "review", "manual_review_note", input.Note, TaskAttrStatusPending); err != nil {
+		if err := s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepAutoScoring, "manual_review_note", input.Note, TaskAttrStatusPending); err != nil {
 			return err
 		}
 
@@ -175,20 +233,90 @@ func (s *leasingWorkflowService) ProcessAutoScoring(ctx context.Context, input A
 		}
 
 		if input.ManualApproved {
-			if err := s.transitionContractStatus(tx, contract, ContractStatusApproved); err != nil {
+			if err := s.contracts.Transition(tx, contract, ContractStatusApproved, contractAudit(actor, "manual review approved", input.Note)); err != nil {
 				return err
 			}
-			return s.updateTaskStatusByKeyword(tx, contract.ContractID, "review", TaskStatusCompleted)
+			return s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepAutoScoring)
 		}
 
-		if err := s.transitionContractStatus(tx, contract, ContractStatusCanceled); err != nil {
-			return err
+		return s.rejectAutoScoring(tx, actor, contract, "manual review rejected", input.Note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return score, nil
+}
+
+// runCreditScoring scores the contract, stores the result on the auto scoring task and applies the decision;
+// a review decision leaves the step in progress for a manual review call.
+func (s *leasingWorkflowService) runCreditScoring(tx *gorm.DB, actor *taskActor, contract *models.LeasingContract, bureau *models.CreditBureauReport, note string) (*CreditScore, error) {
+	applicant, err := loadCreditApplicant(tx, contract)
+	if err != nil {
+		return nil, err
+	}
+	applicant.BureauRequired = s.bureau != nil
+	applicant.ManualReview = contract.ManualReview
+	applicant.Bureau = bureau
+
+	score := scoreApplicant(s.scoring, applicant)
+	score.ContractID = contract.ContractID
+
+	tasks, err := findWorkflowTasks(tx, contract.ContractID, WorkflowStepAutoScoring)
+	if err != nil {
+		return nil, err
+	}
+	attrStatus := TaskAttrStatusCompleted
+	if score.Decision == ScoringDecisionReview {
+		attrStatus = TaskAttrStatusPending
+	}
+	attrs := score.attributes()
+	for i := range attrs {
+		attrs[i].TasaStatus = attrStatus
+		attrs[i].TasaLetaID = tasks[0].TaskID
+	}
+	if err := tx.Create(&attrs).Error; err != nil {
+		return nil, err
+	}
+
+	reason := fmt.Sprintf("auto scoring %s (score %d)", score.Decision, score.Score)
+	switch score.Decision {
+	case ScoringDecisionApprove:
+		if err := s.approveAutoScoring(tx, actor, contract, reason, note); err != nil {
+			return nil, err
 		}
-		if err := s.releaseMotorIfBooked(tx, contract.MotorID); err != nil {
-			return err
+		score.ContractApproved = true
+	case ScoringDecisionReject:
+		if err := s.rejectAutoScoring(tx, actor, contract, reason, note); err != nil {
+			return nil, err
 		}
-		return s.updateTaskStatusByKeyword(tx, contract.ContractID, "review", TaskStatusCancelled)
-	})
+	default:
+		if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusInProgress, WorkflowStepAutoScoring); err != nil {
+			return nil, err
+		}
+		if err := s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepAutoScoring, "manual_review_note", note, TaskAttrStatusPending); err != nil {
+			return nil, err
+		}
+	}
+
+	return &score, nil
+}
+
+func (s *leasingWorkflowService) approveAutoScoring(tx *gorm.DB, actor *taskActor, contract *models.LeasingContract, reason, note string) error {
+	if err := s.contracts.Transition(tx, contract, ContractStatusApproved, contractAudit(actor, reason, note)); err != nil {
+		return err
+	}
+	if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepAutoScoring); err != nil {
+		return err
+	}
+	return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepAutoScoring, "auto_scoring_note", note, TaskAttrStatusCompleted)
+}
+
+func (s *leasingWorkflowService) rejectAutoScoring(tx *gorm.DB, actor *taskActor, contract *models.LeasingContract, reason, note string) error {
+	if err := s.contracts.Transition(tx, contract, ContractStatusCanceled, contractAudit(actor, reason, note)); err != nil {
+		return err
+	}
+	return s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCancelled, WorkflowStepAutoScoring)
 }
 
 func (s *leasingWorkflowService) ProcessSurveyResult(ctx context.Context, input SurveyDecisionInput) error {
@@ -201,28 +329,29 @@ func (s *leasingWorkflowService) ProcessSurveyResult(ctx context.Context, input
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved {
 			return errs.ErrContractNotApproved
 		}
 
 		switch input.Decision {
 		case SurveyDecisionApprove:
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "survei", TaskStatusCompleted); err != nil {
+			if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepFieldSurvey, WorkflowStepSurveyResult); err != nil {
 				return err
 			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "survei", "survey_note", input.Note, TaskAttrStatusCompleted)
+			return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepSurveyResult, "survey_note", input.Note, TaskAttrStatusCompleted)
 
 		case SurveyDecisionReject:
-			if err := s.transitionContractStatus(tx, contract, ContractStatusCanceled); err != nil {
-				return err
-			}
-			if err := s.releaseMotorIfBooked(tx, contract.MotorID); err != nil {
+			if err := s.contracts.Transition(tx, contract, ContractStatusCanceled, contractAudit(actor, "survey rejected", input.Note)); err != nil {
 				return err
 			}
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "survei", TaskStatusCancelled); err != nil {
+			if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCancelled, WorkflowStepFieldSurvey, WorkflowStepSurveyResult); err != nil {
 				return err
 			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "survei", "survey_reject_reason", input.Note, TaskAttrStatusCancelled)
+			return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepSurveyResult, "survey_reject_reason", input.Note, TaskAttrStatusCancelled)
 
 		case SurveyDecisionRequestAdditionalDP:
 			if input.AdditionalDP <= contract.DPDibayar {
@@ -233,32 +362,39 @@ func (s *leasingWorkflowService) ProcessSurveyResult(ctx context.Context, input
 			if err := tx.First(&product, "product_id = ?", contract.ProductID).Error; err != nil {
 				return err
 			}
-			minDP := contract.NilaiKendaraan * product.DPPersenMin / 100
-			maxDP := contract.NilaiKendaraan * product.DPPersenMax / 100
+			minDP, maxDP := dpRange(contract.NilaiKendaraan, &product)
 			if input.AdditionalDP < minDP || input.AdditionalDP > maxDP {
 				return errs.ErrDPOutOfRange
 			}
 
-			pokokPinjaman := contract.NilaiKendaraan - input.AdditionalDP
-			totalPinjaman := calculateTotalPinjaman(pokokPinjaman, product.BungaFlat, contract.TenorBulan)
-			cicilan := totalPinjaman / float64(contract.TenorBulan)
+			interest, err := contractInterestCalculator(contract)
+			if err != nil {
+				return err
+			}
+
+			pricing := priceContract(contract.NilaiKendaraan, input.AdditionalDP, contract.BiayaDibiayai, interest, contract.TenorBulan)
 
 			updates := map[string]interface{}{
 				"dp_dibayar":        input.AdditionalDP,
-				"pokok_pinjaman":    pokokPinjaman,
-				"total_pinjaman":    totalPinjaman,
-				"cicilan_per_bulan": cicilan,
-				"status":            ContractStatusDraft,
+				"pokok_pinjaman":    pricing.PokokPinjaman,
+				"total_pinjaman":    pricing.TotalPinjaman,
+				"cicilan_per_bulan": pricing.CicilanPerBulan,
 			}
 			if err := tx.Model(&models.LeasingContract{}).
 				Where("contract_id = ?", contract.ContractID).
 				Updates(updates).Error; err != nil {
 				return err
 			}
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "survei", TaskStatusCompleted); err != nil {
+			if err := s.contracts.Transition(tx, contract, ContractStatusDraft, contractAudit(actor, "survey requested additional DP", input.Note)); err != nil {
 				return err
 			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "survei", "additional_dp_request", input.Note, TaskAttrStatusPending)
+			if err := refreshPaymentScheduleIfExists(tx, contract.ContractID); err != nil {
+				return err
+			}
+			if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepFieldSurvey, WorkflowStepSurveyResult); err != nil {
+				return err
+			}
+			return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepSurveyResult, "additional_dp_request", input.Note, TaskAttrStatusPending)
 
 		default:
 			return errs.ErrInvalidDecision
@@ -276,27 +412,28 @@ func (s *leasingWorkflowService) ProcessFinalApproval(ctx context.Context, input
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved {
 			return errs.ErrContractNotApproved
 		}
 
 		if input.Approved {
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "approval", TaskStatusCompleted); err != nil {
+			if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepFinalApproval); err != nil {
 				return err
 			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "approval", "final_approval_note", input.Note, TaskAttrStatusCompleted)
+			return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepFinalApproval, "final_approval_note", input.Note, TaskAttrStatusCompleted)
 		}
 
-		if err := s.transitionContractStatus(tx, contract, ContractStatusCanceled); err != nil {
+		if err := s.contracts.Transition(tx, contract, ContractStatusCanceled, contractAudit(actor, "final approval rejected", input.Note)); err != nil {
 			return err
 		}
-		if err := s.releaseMotorIfBooked(tx, contract.MotorID); err != nil {
+		if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCancelled, WorkflowStepFinalApproval); err != nil {
 			return err
 		}
-		if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "approval", TaskStatusCancelled); err != nil {
-			return err
-		}
-		return s.appendTaskNoteByKeyword(tx, contract.ContractID, "approval", "final_approval_reject_reason", input.Note, TaskAttrStatusCancelled)
+		return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepFinalApproval, "final_approval_reject_reason", input.Note, TaskAttrStatusCancelled)
 	})
 }
 
@@ -315,6 +452,10 @@ func (s *leasingWorkflowService) ExecuteAkad(ctx context.Context, input AkadInpu
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved {
 			return errs.ErrContractNotApproved
 		}
@@ -345,16 +486,21 @@ func (s *leasingWorkflowService) ExecuteAkad(ctx context.Context, input AkadInpu
 			return err
 		}
 
-		return s.updateTaskStatusByKeyword(tx, contract.ContractID, "akad", TaskStatusCompleted)
+		if err := syncPaymentSchedule(tx, contract.ContractID); err != nil {
+			return err
+		}
+
+		return s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepAkad)
 	})
 }
 
-func (s *leasingWorkflowService) RecordInitialPayment(ctx context.Context, input InitialPaymentInput) error {
-	if input.ContractID < 1 || strings.TrimSpace(input.NomorBukti) == "" || input.JumlahBayar <= 0 {
-		return errs.ErrInvalidInput
+// RecordInitialPayment requires the DP plus every fee the product charges upfront (BiayaDimuka).
+func (s *leasingWorkflowService) RecordInitialPayment(ctx context.Context, input InitialPaymentInput) (*InitialPaymentResult, error) {
+	if input.ContractID < 1 || strings.TrimSpace(input.NomorBukti) == "" {
+		return nil, errs.ErrInvalidInput
 	}
-	if input.JumlahBayar <= 0 {
-		return errs.ErrInvalidPaymentAmount
+	if input.JumlahBayar.Sign() <= 0 {
+		return nil, errs.ErrInvalidPaymentAmount
 	}
 
 	tanggalBayar := input.TanggalBayar
@@ -362,15 +508,25 @@ func (s *leasingWorkflowService) RecordInitialPayment(ctx context.Context, input
 		tanggalBayar = time.Now()
 	}
 
-	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	var result *InitialPaymentResult
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		contract, err := s.lockContract(tx, input.ContractID)
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved && contract.Status != ContractStatusActive {
 			return errs.ErrInvalidStatusTransition
 		}
 
+		expected := contract.DPDibayar.Add(contract.BiayaDimuka)
+		if input.JumlahBayar < expected {
+			return errs.ErrInvalidPaymentAmount
+		}
+
 		payment := models.Payment{
 			NomorBukti:       strings.TrimSpace(input.NomorBukti),
 			JumlahBayar:      input.JumlahBayar,
@@ -383,8 +539,25 @@ func (s *leasingWorkflowService) RecordInitialPayment(ctx context.Context, input
 			return err
 		}
 
-		return s.updateTaskStatusByKeyword(tx, contract.ContractID, "pembayaran dp", TaskStatusCompleted)
+		result = &InitialPaymentResult{
+			ContractID:     contract.ContractID,
+			PaymentID:      payment.PaymentID,
+			DPDibayar:      contract.DPDibayar,
+			AdminFee:       contract.AdminFee,
+			AsuransiPremi:  contract.AsuransiPremi,
+			BiayaFidusia:   contract.BiayaFidusia,
+			BiayaDibiayai:  contract.BiayaDibiayai,
+			BiayaDimuka:    contract.BiayaDimuka,
+			ExpectedAmount: expected,
+			JumlahBayar:    payment.JumlahBayar,
+		}
+		return s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepInitialPayment)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (s *leasingWorkflowService) ProcessDealerFulfillment(ctx context.Context, input DealerFulfillmentInput) error {
@@ -400,25 +573,29 @@ func (s *leasingWorkflowService) ProcessDealerFulfillment(ctx context.Context, i
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved {
 			return errs.ErrContractNotApproved
 		}
 
 		if input.UnitReadyStock {
-			if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "po", TaskStatusCompleted); err != nil {
+			if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepDealerPO); err != nil {
 				return err
 			}
-			return s.appendTaskNoteByKeyword(tx, contract.ContractID, "po", "unit_stock_note", "unit ready stock", TaskAttrStatusCompleted)
+			return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepDealerPO, "unit_stock_note", "unit ready stock", TaskAttrStatusCompleted)
 		}
 
 		note := strings.TrimSpace(input.Note)
 		if note == "" {
 			note = fmt.Sprintf("inden unit %d minggu", input.EstimatedIndentWeek)
 		}
-		if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "po", TaskStatusInProgress); err != nil {
+		if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusInProgress, WorkflowStepDealerPO); err != nil {
 			return err
 		}
-		return s.appendTaskNoteByKeyword(tx, contract.ContractID, "po", "indent_info", note, TaskAttrStatusPending)
+		return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepDealerPO, "indent_info", note, TaskAttrStatusPending)
 	})
 }
 
@@ -437,26 +614,29 @@ func (s *leasingWorkflowService) CompleteDelivery(ctx context.Context, input Del
 		if err != nil {
 			return err
 		}
+		actor, err := loadTaskActor(tx, input.ActorID)
+		if err != nil {
+			return err
+		}
 		if contract.Status != ContractStatusApproved {
 			return errs.ErrContractNotApproved
 		}
 
-		if err := tx.Model(&models.Motor{}).
-			Where("motor_id = ?", contract.MotorID).
-			Update("status_unit", MotorStatusLeased).Error; err != nil {
-			return err
+		if input.TanggalMulaiCicil != nil && !input.TanggalMulaiCicil.IsZero() {
+			if err := tx.Model(&models.LeasingContract{}).
+				Where("contract_id = ?", contract.ContractID).
+				Update("tanggal_mulai_cicil", *input.TanggalMulaiCicil).Error; err != nil {
+				return err
+			}
+			contract.TanggalMulaiCicil = *input.TanggalMulaiCicil
 		}
 
-		updates := map[string]interface{}{
-			"status": ContractStatusActive,
-		}
-		if input.TanggalMulaiCicil != nil && !input.TanggalMulaiCicil.IsZero() {
-			updates["tanggal_mulai_cicil"] = *input.TanggalMulaiCicil
+		// activation hands the unit over to the customer (motor status leased)
+		if err := s.contracts.Transition(tx, contract, ContractStatusActive, contractAudit(actor, "unit delivered", input.HandoverNote)); err != nil {
+			return err
 		}
 
-		if err := tx.Model(&models.LeasingContract{}).
-			Where("contract_id = ?", contract.ContractID).
-			Updates(updates).Error; err != nil {
+		if err := syncPaymentSchedule(tx, contract.ContractID); err != nil {
 			return err
 		}
 
@@ -476,19 +656,42 @@ func (s *leasingWorkflowService) CompleteDelivery(ctx context.Context, input Del
 			}
 		}
 
-		if err := s.updateTaskStatusByKeyword(tx, contract.ContractID, "delivery", TaskStatusCompleted); err != nil {
+		if err := s.updateTaskStatusByCode(tx, actor, contract.ContractID, TaskStatusCompleted, WorkflowStepDelivery); err != nil {
 			return err
 		}
 		if input.DocumentHandover {
-			if err := s.appendTaskNoteByKeyword(tx, contract.ContractID, "delivery", "document_handover", input.HandoverNote, TaskAttrStatusCompleted); err != nil {
+			if err := s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepDelivery, "document_handover", input.HandoverNote, TaskAttrStatusCompleted); err != nil {
 				return err
 			}
 		}
 
-		return s.appendTaskNoteByKeyword(tx, contract.ContractID, "delivery", "delivery_date", deliveryDate.Format("2006-01-02"), TaskAttrStatusCompleted)
+		return s.appendTaskNoteByCode(tx, contract.ContractID, WorkflowStepDelivery, "delivery_date", deliveryDate.Format("2006-01-02"), TaskAttrStatusCompleted)
 	})
 }
 
+func (s *leasingWorkflowService) ContractStateGraph() ContractStateGraph {
+	return s.contracts.Graph()
+}
+
+func (s *leasingWorkflowService) ContractTransitions(ctx context.Context, contractID int64) (*ContractTransitionOptions, error) {
+	if contractID < 1 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var contract models.LeasingContract
+	if err := s.db.WithContext(ctx).
+		Select("contract_id", "status").
+		First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return nil, err
+	}
+
+	return &ContractTransitionOptions{
+		ContractID:  contract.ContractID,
+		Status:      contract.Status,
+		Transitions: s.contracts.AllowedTransitions(contract.Status),
+	}, nil
+}
+
 func (s *leasingWorkflowService) bootstrapTasksFromTemplate(tx *gorm.DB, contractID int64, startDate time.Time) error {
 	var templates []models.TemplateTask
 	if err := tx.Order("teta_id ASC").Preload("Attributes").Find(&templates).Error; err != nil {
@@ -503,6 +706,7 @@ func (s *leasingWorkflowService) bootstrapTasksFromTemplate(tx *gorm.DB, contrac
 			TaskName:   tmpl.TetaName,
 			StartDate:  startDate,
 			EndDate:    startDate.AddDate(0, 0, 14),
+			TaskCode:   tmpl.TetaCode,
 			SequenceNo: idx + 1,
 			Status:     TaskStatusInProgress,
 			ContractID: contractID,
@@ -537,51 +741,64 @@ func (s *leasingWorkflowService) lockContract(tx *gorm.DB, contractID int64) (*m
 	return &contract, nil
 }
 
-func (s *leasingWorkflowService) transitionContractStatus(tx *gorm.DB, contract *models.LeasingContract, nextStatus string) error {
-	if contract.Status == nextStatus {
-		return nil
+// contractAudit builds the status history entry for a workflow transition, appending the user note when given.
+func contractAudit(actor *taskActor, reason, note string) ContractTransitionAudit {
+	if note = strings.TrimSpace(note); note != "" {
+		reason = reason + ": " + note
 	}
-	if !isAllowedContractTransition(contract.Status, nextStatus) {
-		return errs.ErrInvalidStatusTransition
+	return ContractTransitionAudit{ActorID: actor.userID, Reason: reason}
+}
+
+// taskActor is the authenticated user advancing a workflow step.
+type taskActor struct {
+	userID     int64
+	roleIDs    map[int64]struct{}
+	superAdmin bool
+}
+
+func loadTaskActor(tx *gorm.DB, userID int64) (*taskActor, error) {
+	if userID < 1 {
+		return nil, errs.ErrInvalidInput
 	}
-	if err := tx.Model(&models.LeasingContract{}).
-		Where("contract_id = ?", contract.ContractID).
-		Update("status", nextStatus).Error; err != nil {
-		return err
+
+	var userRoles []models.UserRole
+	if err := tx.Preload("Role").Where("user_id = ?", userID).Find(&userRoles).Error; err != nil {
+		return nil, err
 	}
-	contract.Status = nextStatus
-	return nil
-}
 
-func isAllowedContractTransition(current, next string) bool {
-	allowed := map[string]map[string]bool{
-		ContractStatusDraft: {
-			ContractStatusApproved: true,
-			ContractStatusCanceled: true,
-		},
-		ContractStatusApproved: {
-			ContractStatusDraft:    true, // additional DP / rework setelah survey
-			ContractStatusActive:   true,
-			ContractStatusCanceled: true,
-		},
-		ContractStatusActive: {
-			ContractStatusCanceled: true,
-		},
-	}
-
-	return allowed[current][next]
+	actor := &taskActor{userID: userID, roleIDs: make(map[int64]struct{}, len(userRoles))}
+	for _, userRole := range userRoles {
+		actor.roleIDs[userRole.RoleID] = struct{}{}
+		if userRole.Role.RoleName == RoleSuperAdmin {
+			actor.superAdmin = true
+		}
+	}
+	return actor, nil
 }
 
-func (s *leasingWorkflowService) releaseMotorIfBooked(tx *gorm.DB, motorID int64) error {
-	return tx.Model(&models.Motor{}).
-		Where("motor_id = ? AND status_unit = ?", motorID, MotorStatusBooked).
-		Update("status_unit", MotorStatusReady).Error
+// canHandle reports whether the actor holds the task role; SUPER_ADMIN may act on any task.
+func (a *taskActor) canHandle(roleID int64) bool {
+	if a.superAdmin {
+		return true
+	}
+	_, ok := a.roleIDs[roleID]
+	return ok
 }
 
-func (s *leasingWorkflowService) updateTaskStatusByKeyword(tx *gorm.DB, contractID int64, keyword, status string) error {
-	keyword = strings.TrimSpace(strings.ToLower(keyword))
-	if keyword == "" {
-		return nil
+// updateTaskStatusByCode only lets the actor move tasks assigned to one of their roles.
+// Closing a task (completed or cancelled) records who closed it and when.
+func (s *leasingWorkflowService) updateTaskStatusByCode(tx *gorm.DB, actor *taskActor, contractID int64, status string, codes ...string) error {
+	tasks, err := findWorkflowTasks(tx, contractID, codes...)
+	if err != nil {
+		return err
+	}
+
+	taskIDs := make([]int64, 0, len(tasks))
+	for _, task := range tasks {
+		if !actor.canHandle(task.RoleID) {
+			return errs.ErrTaskRoleMismatch
+		}
+		taskIDs = append(taskIDs, task.TaskID)
 	}
 
 	now := time.Now().UTC()
@@ -595,26 +812,24 @@ func (s *leasingWorkflowService) updateTaskStatusByKeyword(tx *gorm.DB, contract
 	if status == TaskStatusCancelled {
 		updates["actual_enddate"] = now
 	}
+	if status == TaskStatusCompleted || status == TaskStatusCancelled {
+		updates["completed_by"] = actor.userID
+		updates["completed_at"] = now
+	}
 
 	return tx.Model(&models.LeasingTask{}).
-		Where("contract_id = ? AND LOWER(task_name) LIKE ?", contractID, "%"+keyword+"%").
+		Where("task_id IN ?", taskIDs).
 		Updates(updates).Error
 }
 
-func (s *leasingWorkflowService) appendTaskNoteByKeyword(tx *gorm.DB, contractID int64, keyword, name, note, status string) error {
+func (s *leasingWorkflowService) appendTaskNoteByCode(tx *gorm.DB, contractID int64, code, name, note, status string) error {
 	note = strings.TrimSpace(note)
 	if note == "" {
 		return nil
 	}
 
-	var task models.LeasingTask
-	err := tx.Where("contract_id = ? AND LOWER(task_name) LIKE ?", contractID, "%"+strings.ToLower(strings.TrimSpace(keyword))+"%").
-		Order("sequence_no ASC").
-		First(&task).Error
+	tasks, err := findWorkflowTasks(tx, contractID, code)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil
-		}
 		return err
 	}
 
@@ -622,15 +837,36 @@ func (s *leasingWorkflowService) appendTaskNoteByKeyword(tx *gorm.DB, contractID
 		TasaName:   strings.TrimSpace(name),
 		TasaValue:  note,
 		TasaStatus: status,
-		TasaLetaID: task.TaskID,
+		TasaLetaID: tasks[0].TaskID,
 	}
 	return tx.Create(&attr).Error
 }
 
-func calculateTotalPinjaman(pokokPinjaman, bungaFlat float64, tenorBulan int16) float64 {
-	if tenorBulan <= 0 {
-		return pokokPinjaman
+// findWorkflowTasks returns the contract tasks for the given step codes, failing when any step is missing.
+func findWorkflowTasks(tx *gorm.DB, contractID int64, codes ...string) ([]models.LeasingTask, error) {
+	if len(codes) == 0 {
+		return nil, errs.ErrInvalidInput
+	}
+
+	var tasks []models.LeasingTask
+	if err := tx.Where("contract_id = ? AND task_code IN ?", contractID, codes).
+		Order("sequence_no ASC").
+		Find(&tasks).Error; err != nil {
+		return nil, err
 	}
-	margin := pokokPinjaman * (bungaFlat / 100) * (float64(tenorBulan) / 12)
-	return pokokPinjaman + margin
+
+	for _, code := range codes {
+		found := false
+		for _, task := range tasks {
+			if task.TaskCode != nil && *task.TaskCode == code {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &errs.WorkflowStepNotFoundError{ContractID: contractID, StepCode: code}
+		}
+	}
+
+	return tasks, nil
 }
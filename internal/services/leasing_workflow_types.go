@@ -1,16 +1,25 @@
 package services
 
-import "time"
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
 
 const (
 	ContractStatusDraft    = "draft"
 	ContractStatusApproved = "approved"
 	ContractStatusActive   = "active"
+	ContractStatusLate     = "late"
+	ContractStatusPaidOff  = "paid_off"
+	ContractStatusRepo     = "repo"
 	ContractStatusCanceled = "canceled"
 
-	MotorStatusReady  = "ready"
-	MotorStatusBooked = "booked"
-	MotorStatusLeased = "leased"
+	MotorStatusReady    = "ready"
+	MotorStatusBooked   = "booked"
+	MotorStatusLeased   = "leased"
+	MotorStatusReturned = "returned"
+	MotorStatusRepo     = "repo"
 
 	TaskStatusInProgress = "inprogress"
 	TaskStatusCompleted  = "completed"
@@ -19,6 +28,29 @@ const (
 	TaskAttrStatusPending   = "pending"
 	TaskAttrStatusCompleted = "completed"
 	TaskAttrStatusCancelled = "cancelled"
+
+	ScheduleStatusUnpaid  = "unpaid"
+	ScheduleStatusPartial = "partial"
+	ScheduleStatusPaid    = "paid"
+	ScheduleStatusOverdue = "overdue"
+
+	RoleSuperAdmin = "SUPER_ADMIN"
+)
+
+// workflow step codes, stored on mst.template_tasks.teta_code and copied to leasing.leasing_tasks.task_code
+const (
+	WorkflowStepSubmitApplication     = "submit_application"
+	WorkflowStepAutoScoring           = "auto_scoring"
+	WorkflowStepFieldSurvey           = "field_survey"
+	WorkflowStepSurveyResult          = "survey_result"
+	WorkflowStepFinalApproval         = "final_approval"
+	WorkflowStepAkad                  = "akad"
+	WorkflowStepInitialPayment        = "initial_payment"
+	WorkflowStepDealerPO              = "dealer_po"
+	WorkflowStepDelivery              = "delivery"
+	WorkflowStepInstallmentMonitoring = "installment_monitoring"
+	WorkflowStepSystemClosed          = "system_closed"
+	WorkflowStepBPKBRelease           = "bpkb_release" // added on payoff, not part of the template
 )
 
 type SurveyDecision string
@@ -37,18 +69,22 @@ type ContractDocumentInput struct {
 }
 
 type SubmitApplicationInput struct {
+	ActorID    int64
 	CustomerID int64
 	MotorID    int64
 	ProductID  int64
-	DPDibayar  float64
+	DPDibayar  money.Amount
 	TenorBulan int16
 
 	RequestDate *time.Time
 	Documents   []ContractDocumentInput
 }
 
+// AutoScoringDecisionInput takes the decision from the caller, or from the scoring engine when Auto is set.
 type AutoScoringDecisionInput struct {
+	ActorID           int64
 	ContractID        int64
+	Auto              bool
 	AutoApproved      bool
 	ManualReviewReady bool
 	ManualApproved    bool
@@ -56,19 +92,22 @@ type AutoScoringDecisionInput struct {
 }
 
 type SurveyDecisionInput struct {
+	ActorID      int64
 	ContractID   int64
 	Decision     SurveyDecision
-	AdditionalDP float64
+	AdditionalDP money.Amount
 	Note         string
 }
 
 type FinalApprovalInput struct {
+	ActorID    int64
 	ContractID int64
 	Approved   bool
 	Note       string
 }
 
 type AkadInput struct {
+	ActorID              int64
 	ContractID           int64
 	ContractNumber       string
 	AkadDate             time.Time
@@ -77,15 +116,31 @@ type AkadInput struct {
 }
 
 type InitialPaymentInput struct {
+	ActorID          int64
 	ContractID       int64
 	NomorBukti       string
-	JumlahBayar      float64
+	JumlahBayar      money.Amount
 	TanggalBayar     time.Time
 	MetodePembayaran string
 	Provider         string
 }
 
+// InitialPaymentResult breaks down what the customer owes upfront: DP plus the fees not financed.
+type InitialPaymentResult struct {
+	ContractID     int64        `json:"contract_id"`
+	PaymentID      int64        `json:"payment_id"`
+	DPDibayar      money.Amount `json:"dp_dibayar"`
+	AdminFee       money.Amount `json:"admin_fee"`
+	AsuransiPremi  money.Amount `json:"asuransi_premi"`
+	BiayaFidusia   money.Amount `json:"biaya_fidusia"`
+	BiayaDibiayai  money.Amount `json:"biaya_dibiayai"`
+	BiayaDimuka    money.Amount `json:"biaya_dimuka"`
+	ExpectedAmount money.Amount `json:"expected_amount"`
+	JumlahBayar    money.Amount `json:"jumlah_bayar"`
+}
+
 type DealerFulfillmentInput struct {
+	ActorID             int64
 	ContractID          int64
 	UnitReadyStock      bool
 	EstimatedIndentWeek int
@@ -93,6 +148,7 @@ type DealerFulfillmentInput struct {
 }
 
 type DeliveryCompletionInput struct {
+	ActorID            int64
 	ContractID         int64
 	DeliveryDate       time.Time
 	CustomerReceived   bool
@@ -101,3 +157,28 @@ type DeliveryCompletionInput struct {
 	TanggalMulaiCicil  *time.Time
 	ContractDocUploads []ContractDocumentInput
 }
+
+// PayoffQuoteInput prices the payoff on QuoteDate (default: today); a date before today is rejected.
+type PayoffQuoteInput struct {
+	ContractID int64
+	QuoteDate  time.Time
+}
+
+// PayoffInput settles the stored quote QuoteID, as long as TanggalBayar falls within its validity.
+type PayoffInput struct {
+	ActorID          int64
+	ContractID       int64
+	QuoteID          int64
+	NomorBukti       string
+	JumlahBayar      money.Amount
+	TanggalBayar     time.Time
+	MetodePembayaran string
+	Provider         string
+	Note             string
+}
+
+type ContractTransitionOptions struct {
+	ContractID  int64                    `json:"contract_id"`
+	Status      string                   `json:"status"`
+	Transitions []ContractTransitionView `json:"transitions"`
+}
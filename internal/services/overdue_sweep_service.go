@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// overdueSweepLockKey is the pg advisory lock key shared by every replica running the sweep.
+const overdueSweepLockKey int64 = 7_301_001
+
+type OverdueSweepResult struct {
+	AsOf                time.Time `json:"as_of"`
+	Skipped             bool      `json:"skipped"`
+	InstallmentsOverdue int64     `json:"installments_overdue"`
+	ContractsLate       int       `json:"contracts_late"`
+	ContractsCured      int       `json:"contracts_cured"`
+}
+
+// OverdueSweepService flags past-due installments and keeps contract late/active status in line with them.
+type OverdueSweepService interface {
+	Sweep(ctx context.Context) (*OverdueSweepResult, error)
+}
+
+type overdueSweepService struct {
+	db        *gorm.DB
+	contracts *ContractStateMachine
+}
+
+func NewOverdueSweepService(db *gorm.DB, contracts *ContractStateMachine) OverdueSweepService {
+	return &overdueSweepService{db: db, contracts: contracts}
+}
+
+// Sweep runs in one transaction holding a transaction-scoped advisory lock.
+// When another replica already holds the lock the sweep is skipped, not queued.
+// "Today" matches countOverdueInstallments so the state machine guards agree with the sweep.
+func (s *overdueSweepService) Sweep(ctx context.Context) (*OverdueSweepResult, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	result := &OverdueSweepResult{AsOf: today}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", overdueSweepLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			result.Skipped = true
+			return nil
+		}
+
+		marked := tx.Model(&models.PaymentSchedule{}).
+			Where("status_pembayaran IN ? AND jatuh_tempo < ?", []string{ScheduleStatusUnpaid, ScheduleStatusPartial}, today).
+			Update("status_pembayaran", ScheduleStatusOverdue)
+		if marked.Error != nil {
+			return marked.Error
+		}
+		result.InstallmentsOverdue = marked.RowsAffected
+
+		audit := ContractTransitionAudit{Reason: "overdue sweep"}
+
+		var lateContracts []models.LeasingContract
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND EXISTS (?)", ContractStatusActive, overdueInstallments(tx, today)).
+			Find(&lateContracts).Error; err != nil {
+			return err
+		}
+		for i := range lateContracts {
+			if err := s.contracts.Transition(tx, &lateContracts[i], ContractStatusLate, audit); err != nil {
+				return err
+			}
+		}
+		result.ContractsLate = len(lateContracts)
+
+		var curedContracts []models.LeasingContract
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND NOT EXISTS (?)", ContractStatusLate, overdueInstallments(tx, today)).
+			Find(&curedContracts).Error; err != nil {
+			return err
+		}
+		for i := range curedContracts {
+			if err := s.contracts.Transition(tx, &curedContracts[i], ContractStatusActive, audit); err != nil {
+				return err
+			}
+		}
+		result.ContractsCured = len(curedContracts)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// overdueInstallments is the correlated subquery matching unpaid rows of the outer contract past their due date.
+func overdueInstallments(tx *gorm.DB, today time.Time) *gorm.DB {
+	return tx.Session(&gorm.Session{NewDB: true}).
+		Model(&models.PaymentSchedule{}).
+		Select("1").
+		Where("payment.payment_schedule.contract_id = leasing.leasing_contract.contract_id").
+		Where("payment.payment_schedule.status_pembayaran <> ? AND payment.payment_schedule.jatuh_tempo < ?", ScheduleStatusPaid, today)
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	AllocationKindPenalty     = "penalty"
+	AllocationKindInstallment = "installment"
+	AllocationKindCredit      = "credit"
+)
+
+// PaymentPostingService posts customer payments against the installment schedule of a contract.
+type PaymentPostingService interface {
+	PostPayment(ctx context.Context, input PostPaymentInput) (*PaymentPostingResult, error)
+}
+
+type PostPaymentInput struct {
+	ActorID          int64
+	ContractID       int64
+	NomorBukti       string
+	JumlahBayar      money.Amount
+	TanggalBayar     time.Time
+	MetodePembayaran string
+	Provider         string
+}
+
+type PaymentPostingResult struct {
+	Payment       models.Payment             `json:"payment"`
+	Allocations   []models.PaymentAllocation `json:"allocations"`
+	PenaltyPaid   money.Amount               `json:"penalty_paid"`
+	CreditUsed    money.Amount               `json:"credit_used"`
+	CreditBalance money.Amount               `json:"credit_balance"`
+	ContractState string                     `json:"contract_status"`
+}
+
+type paymentPostingService struct {
+	db        *gorm.DB
+	contracts *ContractStateMachine
+}
+
+func NewPaymentPostingService(db *gorm.DB, contracts *ContractStateMachine) PaymentPostingService {
+	return &paymentPostingService{db: db, contracts: contracts}
+}
+
+func (s *paymentPostingService) PostPayment(ctx context.Context, input PostPaymentInput) (*PaymentPostingResult, error) {
+	if input.ContractID < 1 || strings.TrimSpace(input.NomorBukti) == "" {
+		return nil, errs.ErrInvalidInput
+	}
+	amount := input.JumlahBayar
+	if amount.Sign() <= 0 {
+		return nil, errs.ErrInvalidPaymentAmount
+	}
+
+	tanggalBayar := input.TanggalBayar
+	if tanggalBayar.IsZero() {
+		tanggalBayar = time.Now()
+	}
+
+	var result PaymentPostingResult
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var contract models.LeasingContract
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&contract, "contract_id = ?", input.ContractID).Error; err != nil {
+			return err
+		}
+		if contract.Status != ContractStatusActive && contract.Status != ContractStatusLate {
+			return errs.ErrInvalidStatusTransition
+		}
+		if err := requirePaymentSchedule(tx, contract.ContractID); err != nil {
+			return err
+		}
+		var product models.LeasingProduct
+		if err := tx.First(&product, "product_id = ?", contract.ProductID).Error; err != nil {
+			return err
+		}
+
+		payment := models.Payment{
+			NomorBukti:       strings.TrimSpace(input.NomorBukti),
+			JumlahBayar:      amount,
+			TanggalBayar:     tanggalBayar,
+			MetodePembayaran: strings.TrimSpace(input.MetodePembayaran),
+			Provider:         strings.TrimSpace(input.Provider),
+			ContractID:       contract.ContractID,
+		}
+		if err := tx.Create(&payment).Error; err != nil {
+			return err
+		}
+
+		allocations, err := allocatePayment(tx, &contract, &payment, penaltyRuleFromProduct(&product))
+		if err != nil {
+			return err
+		}
+
+		if err := s.settleContractStatus(tx, &contract, input.ActorID); err != nil {
+			return err
+		}
+
+		result = PaymentPostingResult{
+			Payment:       payment,
+			Allocations:   allocations,
+			PenaltyPaid:   sumAllocations(allocations, AllocationKindPenalty),
+			CreditUsed:    money.Min(sumAllocations(allocations, AllocationKindCredit), money.Zero).Neg(),
+			CreditBalance: contract.SaldoKredit,
+			ContractState: contract.Status,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// allocatePayment spends the payment plus any held contract credit on open installments, oldest due first.
+// Denda accrued up to the payment date is settled on every installment before any pokok/margin.
+// Allocation lines always sum up to the payment amount: a negative credit line records credit consumed,
+// a positive one records the overpayment kept as credit.
+func allocatePayment(tx *gorm.DB, contract *models.LeasingContract, payment *models.Payment, rule penaltyRule) ([]models.PaymentAllocation, error) {
+	var schedules []models.PaymentSchedule
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("contract_id = ? AND status_pembayaran <> ?", contract.ContractID, ScheduleStatusPaid).
+		Order("jatuh_tempo ASC, angsuran_ke ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	previousCredit := contract.SaldoKredit
+	available := payment.JumlahBayar.Add(previousCredit)
+	allocations := make([]models.PaymentAllocation, 0, 2*len(schedules)+1)
+
+	for i := range schedules {
+		schedule := &schedules[i]
+		denda := rule.accrue(schedule, payment.TanggalBayar)
+		updates := map[string]interface{}{}
+		if denda != schedule.Denda {
+			schedule.Denda = denda
+			updates["denda"] = denda
+		}
+
+		outstanding := schedule.Denda.Sub(schedule.DendaTerbayar)
+		if available.Sign() > 0 && outstanding.Sign() > 0 {
+			portion := money.Min(outstanding, available)
+			available = available.Sub(portion)
+			schedule.DendaTerbayar = schedule.DendaTerbayar.Add(portion)
+			updates["denda_terbayar"] = schedule.DendaTerbayar
+			allocations = append(allocations, newAllocation(payment, schedule, AllocationKindPenalty, portion))
+		}
+
+		if len(updates) == 0 {
+			continue
+		}
+		if err := tx.Model(&models.PaymentSchedule{}).
+			Where("schedule_id = ?", schedule.ScheduleID).
+			Updates(updates).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range schedules {
+		if available.Sign() <= 0 {
+			break
+		}
+		schedule := &schedules[i]
+		outstanding := schedule.TotalTagihan.Sub(schedule.Terbayar)
+		if outstanding.Sign() <= 0 {
+			continue
+		}
+
+		portion := money.Min(outstanding, available)
+		available = available.Sub(portion)
+		schedule.Terbayar = schedule.Terbayar.Add(portion)
+
+		status := ScheduleStatusPartial
+		if schedule.StatusPembayaran == ScheduleStatusOverdue {
+			status = ScheduleStatusOverdue
+		}
+		updates := map[string]interface{}{
+			"terbayar":          schedule.Terbayar,
+			"status_pembayaran": status,
+		}
+		if schedule.Terbayar >= schedule.TotalTagihan {
+			updates["status_pembayaran"] = ScheduleStatusPaid
+			updates["tanggal_bayar"] = payment.TanggalBayar
+		}
+		if err := tx.Model(&models.PaymentSchedule{}).
+			Where("schedule_id = ?", schedule.ScheduleID).
+			Updates(updates).Error; err != nil {
+			return nil, err
+		}
+
+		allocations = append(allocations, newAllocation(payment, schedule, AllocationKindInstallment, portion))
+	}
+
+	creditDelta := available.Sub(previousCredit)
+	if !creditDelta.IsZero() {
+		allocations = append(allocations, newAllocation(payment, nil, AllocationKindCredit, creditDelta))
+	}
+	if len(allocations) > 0 {
+		if err := tx.Create(&allocations).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if available != previousCredit {
+		if err := tx.Model(&models.LeasingContract{}).
+			Where("contract_id = ?", contract.ContractID).
+			Update("saldo_kredit", available).Error; err != nil {
+			return nil, err
+		}
+		contract.SaldoKredit = available
+	}
+
+	// keep the legacy single-schedule link pointing at the oldest installment this payment touched
+	for _, allocation := range allocations {
+		if allocation.ScheduleID != nil {
+			payment.ScheduleID = allocation.ScheduleID
+			if err := tx.Model(&models.Payment{}).
+				Where("payment_id = ?", payment.PaymentID).
+				Update("schedule_id", *allocation.ScheduleID).Error; err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	return allocations, nil
+}
+
+func newAllocation(payment *models.Payment, schedule *models.PaymentSchedule, kind string, amount money.Amount) models.PaymentAllocation {
+	allocation := models.PaymentAllocation{
+		Kind:      kind,
+		Amount:    amount,
+		PaymentID: payment.PaymentID,
+	}
+	if schedule != nil {
+		scheduleID := schedule.ScheduleID
+		allocation.ScheduleID = &scheduleID
+	}
+	return allocation
+}
+
+func sumAllocations(allocations []models.PaymentAllocation, kind string) money.Amount {
+	var total money.Amount
+	for _, allocation := range allocations {
+		if allocation.Kind == kind {
+			total = total.Add(allocation.Amount)
+		}
+	}
+	return total
+}
+
+// settleContractStatus closes a contract once it has installment rows and all of them are paid, and brings
+// a late one back once nothing is overdue.
+func (s *paymentPostingService) settleContractStatus(tx *gorm.DB, contract *models.LeasingContract, actorID int64) error {
+	err := requireNoOutstandingInstallment(tx, contract)
+	switch {
+	case err == nil:
+		return s.contracts.Transition(tx, contract, ContractStatusPaidOff, ContractTransitionAudit{
+			ActorID: actorID,
+			Reason:  "all installments paid",
+		})
+	case !errors.Is(err, errs.ErrContractOutstanding):
+		return err
+	}
+
+	if contract.Status != ContractStatusLate {
+		return nil
+	}
+	overdue, err := countOverdueInstallments(tx, contract.ContractID)
+	if err != nil || overdue > 0 {
+		return err
+	}
+	return s.contracts.Transition(tx, contract, ContractStatusActive, ContractTransitionAudit{
+		ActorID: actorID,
+		Reason:  "overdue installments paid",
+	})
+}
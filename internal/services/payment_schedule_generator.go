@@ -0,0 +1,223 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// contractPricing holds the financed amounts derived from OTR, DP, financed fees, interest method and tenor.
+type contractPricing struct {
+	PokokPinjaman   money.Amount
+	TotalPinjaman   money.Amount
+	CicilanPerBulan money.Amount
+}
+
+// dpRange is the down payment window a product allows for the given OTR price, rounded to rupiah.
+func dpRange(nilaiKendaraan money.Amount, product *models.LeasingProduct) (money.Amount, money.Amount) {
+	return nilaiKendaraan.MulRate(product.DPPersenMin), nilaiKendaraan.MulRate(product.DPPersenMax)
+}
+
+// resolveTenor uses the requested tenor when given, otherwise the product default.
+func resolveTenor(product *models.LeasingProduct, requested int16) (int16, error) {
+	tenor := product.TenorBulan
+	if requested > 0 {
+		tenor = requested
+	}
+	if tenor <= 0 {
+		return 0, errs.ErrInvalidInput
+	}
+	return tenor, nil
+}
+
+// priceContract finances OTR minus DP plus the fees the product adds to the loan (contractFees.Dibiayai).
+// CicilanPerBulan is rounded to rupiah; the schedule rows, not this figure, carry the exact split.
+func priceContract(nilaiKendaraan, dpDibayar, biayaDibiayai money.Amount, interest InterestCalculator, tenorBulan int16) contractPricing {
+	pokokPinjaman := nilaiKendaraan.Sub(dpDibayar).Add(biayaDibiayai)
+	totalPinjaman := interest.TotalPinjaman(pokokPinjaman, tenorBulan)
+
+	cicilan := totalPinjaman
+	if tenorBulan > 0 {
+		cicilan = totalPinjaman.Div(int64(tenorBulan))
+	}
+
+	return contractPricing{
+		PokokPinjaman:   pokokPinjaman,
+		TotalPinjaman:   totalPinjaman,
+		CicilanPerBulan: cicilan,
+	}
+}
+
+// buildPaymentSchedule splits pokok and margin over the tenor using the product interest method.
+// The rows always sum up to the contract totals.
+func buildPaymentSchedule(contract *models.LeasingContract, interest InterestCalculator) []models.PaymentSchedule {
+	splits := interest.Split(contract.PokokPinjaman, contract.TotalPinjaman, contract.TenorBulan)
+
+	rows := make([]models.PaymentSchedule, 0, len(splits))
+	for i, split := range splits {
+		rows = append(rows, models.PaymentSchedule{
+			AngsuranKe:       int16(i + 1),
+			JatuhTempo:       addMonthsClamped(contract.TanggalMulaiCicil, i),
+			Pokok:            split.Pokok,
+			Margin:           split.Margin,
+			TotalTagihan:     split.Pokok.Add(split.Margin),
+			StatusPembayaran: ScheduleStatusUnpaid,
+			ContractID:       contract.ContractID,
+		})
+	}
+
+	return rows
+}
+
+// syncPaymentSchedule reprices the contract from its own interest terms and fees (both frozen at submission)
+// and (re)generates the installment rows. Fees are never re-priced here: the upfront part was already
+// collected with the DP.
+// It is idempotent: nothing is written when the stored schedule already matches the contract terms.
+func syncPaymentSchedule(tx *gorm.DB, contractID int64) error {
+	var contract models.LeasingContract
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return err
+	}
+	if contract.TenorBulan <= 0 || contract.TanggalMulaiCicil.IsZero() {
+		return errs.ErrInvalidInput
+	}
+
+	interest, err := contractInterestCalculator(&contract)
+	if err != nil {
+		return err
+	}
+
+	pricing := priceContract(contract.NilaiKendaraan, contract.DPDibayar, contract.BiayaDibiayai, interest, contract.TenorBulan)
+	if contract.PokokPinjaman != pricing.PokokPinjaman ||
+		contract.TotalPinjaman != pricing.TotalPinjaman ||
+		contract.CicilanPerBulan != pricing.CicilanPerBulan {
+		if err := tx.Model(&models.LeasingContract{}).
+			Where("contract_id = ?", contract.ContractID).
+			Updates(map[string]interface{}{
+				"pokok_pinjaman":    pricing.PokokPinjaman,
+				"total_pinjaman":    pricing.TotalPinjaman,
+				"cicilan_per_bulan": pricing.CicilanPerBulan,
+			}).Error; err != nil {
+			return err
+		}
+		contract.PokokPinjaman = pricing.PokokPinjaman
+		contract.TotalPinjaman = pricing.TotalPinjaman
+		contract.CicilanPerBulan = pricing.CicilanPerBulan
+	}
+
+	expected := buildPaymentSchedule(&contract, interest)
+	if err := reconcileSchedule(&contract, expected); err != nil {
+		return err
+	}
+
+	var existing []models.PaymentSchedule
+	if err := tx.Where("contract_id = ?", contract.ContractID).
+		Order("angsuran_ke ASC").
+		Find(&existing).Error; err != nil {
+		return err
+	}
+	if samePaymentSchedule(existing, expected) {
+		return nil
+	}
+
+	for _, row := range existing {
+		if row.StatusPembayaran != ScheduleStatusUnpaid {
+			return errs.ErrPaymentScheduleLocked
+		}
+	}
+
+	if len(existing) > 0 {
+		if err := tx.Where("contract_id = ?", contract.ContractID).
+			Delete(&models.PaymentSchedule{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Create(&expected).Error
+}
+
+// reconcileSchedule asserts the installment rows add up exactly to the contract pokok and TotalPinjaman.
+func reconcileSchedule(contract *models.LeasingContract, rows []models.PaymentSchedule) error {
+	var pokok, total money.Amount
+	for _, row := range rows {
+		if row.TotalTagihan != row.Pokok.Add(row.Margin) {
+			return fmt.Errorf("%w: installment %d total %s != pokok %s + margin %s",
+				errs.ErrScheduleNotReconciled, row.AngsuranKe, row.TotalTagihan, row.Pokok, row.Margin)
+		}
+		pokok = pokok.Add(row.Pokok)
+		total = total.Add(row.TotalTagihan)
+	}
+	if pokok != contract.PokokPinjaman || total != contract.TotalPinjaman {
+		return fmt.Errorf("%w: contract %d installments sum to pokok %s / total %s, expected %s / %s",
+			errs.ErrScheduleNotReconciled, contract.ContractID, pokok, total, contract.PokokPinjaman, contract.TotalPinjaman)
+	}
+	return nil
+}
+
+// refreshPaymentScheduleIfExists regenerates the schedule only for contracts that already have one.
+func refreshPaymentScheduleIfExists(tx *gorm.DB, contractID int64) error {
+	count, err := countPaymentScheduleRows(tx, contractID)
+	if err != nil || count == 0 {
+		return err
+	}
+	return syncPaymentSchedule(tx, contractID)
+}
+
+// requirePaymentSchedule rejects money movements on a contract whose installments were never generated.
+func requirePaymentSchedule(tx *gorm.DB, contractID int64) error {
+	count, err := countPaymentScheduleRows(tx, contractID)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errs.ErrNoPaymentSchedule
+	}
+	return nil
+}
+
+func countPaymentScheduleRows(tx *gorm.DB, contractID int64) (int64, error) {
+	var count int64
+	err := tx.Model(&models.PaymentSchedule{}).
+		Where("contract_id = ?", contractID).
+		Count(&count).Error
+	return count, err
+}
+
+func samePaymentSchedule(existing, expected []models.PaymentSchedule) bool {
+	if len(existing) != len(expected) {
+		return false
+	}
+	for i := range expected {
+		if existing[i].AngsuranKe != expected[i].AngsuranKe ||
+			!sameDate(existing[i].JatuhTempo, expected[i].JatuhTempo) ||
+			existing[i].Pokok != expected[i].Pokok ||
+			existing[i].Margin != expected[i].Margin ||
+			existing[i].TotalTagihan != expected[i].TotalTagihan {
+			return false
+		}
+	}
+	return true
+}
+
+// addMonthsClamped keeps the due day stable, falling back to the month end for shorter months.
+func addMonthsClamped(start time.Time, months int) time.Time {
+	year, month, day := start.Date()
+	firstOfTarget := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, start.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, 0, 0, 0, 0, start.Location())
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
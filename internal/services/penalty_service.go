@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+	"gorm.io/gorm"
+)
+
+// penalty types configured on leasing.leasing_product.denda_tipe
+const (
+	PenaltyTypeDailyRate = "daily_rate"
+	PenaltyTypeFixed     = "fixed"
+)
+
+// penaltyRule is the denda setting of one product.
+// daily_rate charges DendaNilai percent of the unpaid installment per late day beyond the grace period;
+// fixed charges DendaNilai once the grace period has passed. A zero cap means uncapped.
+type penaltyRule struct {
+	Type      string
+	Value     float64
+	GraceDays int
+	Cap       money.Amount
+}
+
+func penaltyRuleFromProduct(product *models.LeasingProduct) penaltyRule {
+	return penaltyRule{
+		Type:      product.DendaTipe,
+		Value:     product.DendaNilai,
+		GraceDays: int(product.DendaGraceHari),
+		Cap:       product.DendaMaks,
+	}
+}
+
+// daysLate counts whole calendar days between the due date and asOf.
+func daysLate(jatuhTempo, asOf time.Time) int {
+	due := time.Date(jatuhTempo.Year(), jatuhTempo.Month(), jatuhTempo.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	if !day.After(due) {
+		return 0
+	}
+	return int(day.Sub(due).Hours() / 24)
+}
+
+// accrue returns the denda owed on an installment as of the given date.
+// Paid installments keep the denda already booked on them; accrued denda never goes down.
+func (r penaltyRule) accrue(schedule *models.PaymentSchedule, asOf time.Time) money.Amount {
+	booked := schedule.Denda
+	if schedule.StatusPembayaran == ScheduleStatusPaid || r.Value <= 0 {
+		return booked
+	}
+
+	chargeableDays := daysLate(schedule.JatuhTempo, asOf) - r.GraceDays
+	if chargeableDays <= 0 {
+		return booked
+	}
+
+	var amount money.Amount
+	switch r.Type {
+	case PenaltyTypeDailyRate:
+		unpaid := money.Max(schedule.TotalTagihan.Sub(schedule.Terbayar), money.Zero)
+		amount = unpaid.MulRateFrac(r.Value, int64(chargeableDays), 1)
+	case PenaltyTypeFixed:
+		amount = money.FromFloat(r.Value).Round()
+	}
+	if r.Cap.Sign() > 0 && amount > r.Cap {
+		amount = r.Cap
+	}
+
+	return money.Max(amount, booked)
+}
+
+type InstallmentPenalty struct {
+	ScheduleID       int64        `json:"schedule_id"`
+	AngsuranKe       int16        `json:"angsuran_ke"`
+	JatuhTempo       time.Time    `json:"jatuh_tempo"`
+	StatusPembayaran string       `json:"status_pembayaran"`
+	DaysLate         int          `json:"days_late"`
+	TagihanSisa      money.Amount `json:"tagihan_sisa"`
+	Denda            money.Amount `json:"denda"`
+	DendaTerbayar    money.Amount `json:"denda_terbayar"`
+	DendaSisa        money.Amount `json:"denda_sisa"`
+	TotalDue         money.Amount `json:"total_due"`
+}
+
+type ContractPenaltyBreakdown struct {
+	ContractID       int64                `json:"contract_id"`
+	AsOf             time.Time            `json:"as_of"`
+	PenaltyType      string               `json:"penalty_type"`
+	PenaltyValue     float64              `json:"penalty_value"`
+	GraceDays        int                  `json:"grace_days"`
+	PenaltyCap       money.Amount         `json:"penalty_cap"`
+	Installments     []InstallmentPenalty `json:"installments"`
+	TotalTagihanSisa money.Amount         `json:"total_tagihan_sisa"`
+	TotalDendaSisa   money.Amount         `json:"total_denda_sisa"`
+	TotalDue         money.Amount         `json:"total_due"`
+}
+
+// PenaltyService computes late-payment penalties (denda) for contract installments.
+type PenaltyService interface {
+	ContractPenalties(ctx context.Context, contractID int64, asOf time.Time) (*ContractPenaltyBreakdown, error)
+}
+
+type penaltyService struct {
+	db *gorm.DB
+}
+
+func NewPenaltyService(db *gorm.DB) PenaltyService {
+	return &penaltyService{db: db}
+}
+
+func (s *penaltyService) ContractPenalties(ctx context.Context, contractID int64, asOf time.Time) (*ContractPenaltyBreakdown, error) {
+	if contractID < 1 {
+		return nil, errs.ErrInvalidInput
+	}
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+
+	db := s.db.WithContext(ctx)
+
+	var contract models.LeasingContract
+	if err := db.Preload("Product").First(&contract, "contract_id = ?", contractID).Error; err != nil {
+		return nil, err
+	}
+
+	var schedules []models.PaymentSchedule
+	if err := db.Where("contract_id = ?", contractID).
+		Order("jatuh_tempo ASC, angsuran_ke ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	rule := penaltyRuleFromProduct(&contract.Product)
+	breakdown := &ContractPenaltyBreakdown{
+		ContractID:   contractID,
+		AsOf:         asOf,
+		PenaltyType:  rule.Type,
+		PenaltyValue: rule.Value,
+		GraceDays:    rule.GraceDays,
+		PenaltyCap:   rule.Cap,
+		Installments: make([]InstallmentPenalty, 0, len(schedules)),
+	}
+
+	for i := range schedules {
+		schedule := &schedules[i]
+		denda := rule.accrue(schedule, asOf)
+		tagihanSisa := money.Max(schedule.TotalTagihan.Sub(schedule.Terbayar), money.Zero)
+		dendaSisa := money.Max(denda.Sub(schedule.DendaTerbayar), money.Zero)
+
+		item := InstallmentPenalty{
+			ScheduleID:       schedule.ScheduleID,
+			AngsuranKe:       schedule.AngsuranKe,
+			JatuhTempo:       schedule.JatuhTempo,
+			StatusPembayaran: schedule.StatusPembayaran,
+			TagihanSisa:      tagihanSisa,
+			Denda:            denda,
+			DendaTerbayar:    schedule.DendaTerbayar,
+			DendaSisa:        dendaSisa,
+			TotalDue:         tagihanSisa.Add(dendaSisa),
+		}
+		if schedule.StatusPembayaran != ScheduleStatusPaid {
+			item.DaysLate = daysLate(schedule.JatuhTempo, asOf)
+		}
+
+		breakdown.Installments = append(breakdown.Installments, item)
+		breakdown.TotalTagihanSisa = breakdown.TotalTagihanSisa.Add(tagihanSisa)
+		breakdown.TotalDendaSisa = breakdown.TotalDendaSisa.Add(dendaSisa)
+	}
+	breakdown.TotalDue = breakdown.TotalTagihanSisa.Add(breakdown.TotalDendaSisa)
+
+	return breakdown, nil
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"time"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/repository"
 	"github.com/HendraaaIrwn/honda-leasing-api/pkg/database"
 )
@@ -26,14 +29,16 @@ type MSTServices struct {
 }
 
 type DealerServices struct {
-	MotorType  MotorTypeService
-	Motor      MotorService
-	MotorAsset MotorAssetService
-	Customer   CustomerService
+	MotorType         MotorTypeService
+	Motor             MotorService
+	MotorAsset        MotorAssetService
+	Customer          CustomerService
+	CustomerBlacklist CustomerBlacklistService
 }
 
 type LeasingServices struct {
 	LeasingProduct          LeasingProductService
+	InsuranceRate           InsuranceRateService
 	LeasingContract         LeasingContractService
 	LeasingTask             LeasingTaskService
 	LeasingTaskAttribute    LeasingTaskAttributeService
@@ -44,19 +49,43 @@ type LeasingServices struct {
 type PaymentServices struct {
 	PaymentSchedule PaymentScheduleService
 	Payment         PaymentService
+	Posting         PaymentPostingService
+	Penalty         PenaltyService
+	OverdueSweep    OverdueSweepService
 }
 
 // Services is the domain service registry.
 type Services struct {
-	Account AccountServices
-	MST     MSTServices
-	Dealer  DealerServices
-	Leasing LeasingServices
-	Payment PaymentServices
+	Auth          AuthService
+	Authorization AuthorizationService
+	Account       AccountServices
+	MST           MSTServices
+	Dealer        DealerServices
+	Leasing       LeasingServices
+	Payment       PaymentServices
+	Files         FileService
 }
 
-func NewServices(repos *repository.Repositories) *Services {
+// NewServices fails when a security-relevant setting is missing, so a misconfigured deployment never starts.
+func NewServices(repos *repository.Repositories, cfg *configs.Config) (*Services, error) {
+	contracts := NewContractStateMachine()
+
+	auth, err := NewAuthService(repos.DB(), cfg.JWT, cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+	bureau, err := NewCreditBureauClient(cfg.Bureau)
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewFileStorage(cfg.Storage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Services{
+		Auth:          auth,
+		Authorization: NewAuthorizationService(repos.DB(), time.Duration(cfg.Auth.PermissionCacheSeconds)*time.Second),
 		Account: AccountServices{
 			OAuthProvider:     NewOAuthProviderService(repos.Account.OAuthProvider),
 			User:              NewUserService(repos.Account.User),
@@ -76,27 +105,33 @@ func NewServices(repos *repository.Repositories) *Services {
 			TemplateTaskAttribute: NewTemplateTaskAttributeService(repos.MST.TemplateTaskAttribute),
 		},
 		Dealer: DealerServices{
-			MotorType:  NewMotorTypeService(repos.Dealer.MotorType),
-			Motor:      NewMotorService(repos.Dealer.Motor),
-			MotorAsset: NewMotorAssetService(repos.Dealer.MotorAsset),
-			Customer:   NewCustomerService(repos.Dealer.Customer),
+			MotorType:         NewMotorTypeService(repos.Dealer.MotorType),
+			Motor:             NewMotorService(repos.Dealer.Motor),
+			MotorAsset:        NewMotorAssetService(repos.Dealer.MotorAsset),
+			Customer:          NewCustomerService(repos.Dealer.Customer),
+			CustomerBlacklist: NewCustomerBlacklistService(repos.Dealer.CustomerBlacklist),
 		},
 		Leasing: LeasingServices{
 			LeasingProduct:          NewLeasingProductService(repos.Leasing.LeasingProduct),
-			LeasingContract:         NewLeasingContractService(repos.Leasing.LeasingContract),
+			InsuranceRate:           NewInsuranceRateService(repos.Leasing.InsuranceRate),
+			LeasingContract:         NewLeasingContractService(repos.Leasing.LeasingContract, repos.DB(), contracts),
 			LeasingTask:             NewLeasingTaskService(repos.Leasing.LeasingTask),
 			LeasingTaskAttribute:    NewLeasingTaskAttributeService(repos.Leasing.LeasingTaskAttribute),
 			LeasingContractDocument: NewLeasingContractDocumentService(repos.Leasing.LeasingContractDocument),
-			Workflow:                NewLeasingWorkflowService(repos.DB()),
+			Workflow:                NewLeasingWorkflowService(repos.DB(), contracts, cfg.Payoff, cfg.Scoring, cfg.Submission, bureau, cfg.Bureau.CacheDays),
 		},
 		Payment: PaymentServices{
 			PaymentSchedule: NewPaymentScheduleService(repos.Payment.PaymentSchedule),
 			Payment:         NewPaymentService(repos.Payment.Payment),
+			Posting:         NewPaymentPostingService(repos.DB(), contracts),
+			Penalty:         NewPenaltyService(repos.DB()),
+			OverdueSweep:    NewOverdueSweepService(repos.DB(), contracts),
 		},
-	}
+		Files: NewFileService(repos.DB(), store, cfg.Storage, cfg.Server.BasePath),
+	}, nil
 }
 
-func NewServicesFromDatabase(db *database.Database) *Services {
+func NewServicesFromDatabase(db *database.Database, cfg *configs.Config) (*Services, error) {
 	repos := repository.NewRepositoriesFromDatabase(db)
-	return NewServices(repos)
+	return NewServices(repos, cfg)
 }
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"strings"
+
+	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/domain/models"
+	errs "github.com/HendraaaIrwn/honda-leasing-api/internal/errors"
+	"gorm.io/gorm"
+)
+
+// actions of a pre-submission check, configured in [SUBMISSION_CHECKS]
+const (
+	SubmissionActionReject = "reject"
+	SubmissionActionReview = "review"
+	SubmissionActionOff    = "off"
+)
+
+// pre-submission reason codes, returned on rejection or stored on leasing_contract.review_reasons
+const (
+	SubmissionReasonInFlightContract  = "INFLIGHT_CONTRACT"
+	SubmissionReasonDuplicateIdentity = "DUPLICATE_IDENTITY"
+	SubmissionReasonRepossessed       = "PREVIOUSLY_REPOSSESSED"
+	SubmissionReasonBlacklisted       = "BLACKLISTED"
+)
+
+type submissionCheck struct {
+	reason string
+	action string
+	hit    func(tx *gorm.DB, customer *models.Customer) (bool, error)
+}
+
+// runSubmissionChecks returns the reasons that flag the application for manual review, or an
+// ApplicationRejectedError when any check configured to reject is hit.
+func runSubmissionChecks(tx *gorm.DB, cfg configs.SubmissionConfig, customer *models.Customer) ([]string, error) {
+	checks := []submissionCheck{
+		{reason: SubmissionReasonBlacklisted, action: cfg.Blacklist, hit: isBlacklisted},
+		{reason: SubmissionReasonInFlightContract, action: cfg.InFlightContract, hit: hasInFlightContract},
+		{reason: SubmissionReasonDuplicateIdentity, action: cfg.DuplicateIdentity, hit: hasDuplicateIdentity},
+		{reason: SubmissionReasonRepossessed, action: cfg.Repossessed, hit: wasRepossessed},
+	}
+
+	var rejected, review []string
+	for _, check := range checks {
+		action := strings.ToLower(strings.TrimSpace(check.action))
+		if action == "" || action == SubmissionActionOff {
+			continue
+		}
+
+		hit, err := check.hit(tx, customer)
+		if err != nil {
+			return nil, err
+		}
+		if !hit {
+			continue
+		}
+
+		if action == SubmissionActionReview {
+			review = append(review, check.reason)
+			continue
+		}
+		rejected = append(rejected, check.reason)
+	}
+
+	if len(rejected) > 0 {
+		return nil, &errs.ApplicationRejectedError{CustomerID: customer.CustomerID, Reasons: rejected}
+	}
+	return review, nil
+}
+
+// isBlacklisted matches unexpired dealer.customer_blacklist entries by NIK or customer id.
+func isBlacklisted(tx *gorm.DB, customer *models.Customer) (bool, error) {
+	var count int64
+	err := tx.Model(&models.CustomerBlacklist{}).
+		Where("(nik = ? OR customer_id = ?) AND (expires_at IS NULL OR expires_at > NOW())",
+			normalizeNIK(customer.NIK), customer.CustomerID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// hasInFlightContract finds another contract of the customer still in draft or approved.
+func hasInFlightContract(tx *gorm.DB, customer *models.Customer) (bool, error) {
+	var count int64
+	err := tx.Model(&models.LeasingContract{}).
+		Where("customer_id = ? AND status IN ?", customer.CustomerID, []string{ContractStatusDraft, ContractStatusApproved}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// hasDuplicateIdentity finds another customer record with the same NIK or phone once formatting is stripped
+// (spaces, dashes, +62 vs 0 prefix).
+func hasDuplicateIdentity(tx *gorm.DB, customer *models.Customer) (bool, error) {
+	var count int64
+	err := tx.Model(&models.Customer{}).
+		Where("customer_id <> ?", customer.CustomerID).
+		Where("regexp_replace(nik, '[^0-9]', '', 'g') = ? OR regexp_replace(regexp_replace(no_hp, '[^0-9]', '', 'g'), '^62', '0') = ?",
+			normalizeNIK(customer.NIK), normalizePhone(customer.NoHP)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// wasRepossessed looks at the status history so a contract redeemed after repossession still counts.
+func wasRepossessed(tx *gorm.DB, customer *models.Customer) (bool, error) {
+	var count int64
+	err := tx.Model(&models.ContractStatusHistory{}).
+		Joins("JOIN leasing.leasing_contract lc ON lc.contract_id = contract_status_history.contract_id").
+		Where("lc.customer_id = ? AND contract_status_history.to_status = ?", customer.CustomerID, ContractStatusRepo).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func normalizeNIK(nik string) string {
+	return digitsOnly(nik)
+}
+
+func normalizePhone(phone string) string {
+	digits := digitsOnly(phone)
+	if strings.HasPrefix(digits, "62") {
+		return "0" + digits[2:]
+	}
+	return digits
+}
+
+func digitsOnly(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -1,20 +1,28 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/HendraaaIrwn/honda-leasing-api/api/routers"
 	configs "github.com/HendraaaIrwn/honda-leasing-api/internal/config"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/handler"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/repository"
+	"github.com/HendraaaIrwn/honda-leasing-api/internal/scheduler"
 	"github.com/HendraaaIrwn/honda-leasing-api/internal/services"
 	"github.com/HendraaaIrwn/honda-leasing-api/pkg/database"
 	"github.com/gin-gonic/gin"
 )
 
+// shutdownTimeout bounds how long in-flight requests may take after SIGINT/SIGTERM.
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	cfg, err := configs.LoadConfig()
 	if err != nil {
@@ -43,7 +51,38 @@ func main() {
 	}
 
 	repos := repository.NewRepositoriesFromDatabase(db)
-	svcs := services.NewServices(repos)
+	svcs, err := services.NewServices(repos, cfg)
+	if err != nil {
+		log.Fatalf("Error setting up services: %v", err)
+	}
+
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1:], svcs); err != nil {
+			log.Fatalf("Error running command %s: %v", os.Args[1], err)
+		}
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// jobs get their own context so they are stopped only after the server has drained
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+
+	var jobs *scheduler.Scheduler
+	if cfg.Scheduler.Enabled {
+		jobs = scheduler.New()
+		err := jobs.Every("overdue_sweep", cfg.Scheduler.OverdueSweepInterval, func(ctx context.Context) error {
+			_, err := svcs.Payment.OverdueSweep.Sweep(ctx)
+			return err
+		})
+		if err != nil {
+			log.Fatalf("Error configuring scheduler: %v", err)
+		}
+		jobs.Start(jobsCtx)
+	}
+
 	handlers := handler.NewHandlers(svcs)
 
 	routers.RegisterERDRouters(engine, cfg.Server.BasePath, handlers)
@@ -55,8 +94,31 @@ func main() {
 		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
 	}
 
-	log.Printf("Server listening on %s%s", cfg.Server.Address, cfg.Server.BasePath)
-	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		log.Fatalf("Error starting server: %v", err)
+	serverErr := make(chan error, 1)
+	go func() {
+		log.Printf("Server listening on %s%s", cfg.Server.Address, cfg.Server.BasePath)
+		serverErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Error starting server: %v", err)
+		}
+	case <-ctx.Done():
+		log.Printf("Shutdown signal received, draining requests and jobs")
+	}
+	stop()
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error shutting down server: %v", err)
+	}
+
+	cancelJobs()
+	if jobs != nil {
+		jobs.Wait()
 	}
+	log.Printf("Server stopped")
 }
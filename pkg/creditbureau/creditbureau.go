@@ -0,0 +1,36 @@
+package creditbureau
+
+import (
+	"time"
+
+	"github.com/HendraaaIrwn/honda-leasing-api/pkg/money"
+)
+
+// ProviderNone disables the bureau check; it is the default so the fake is never used unless selected.
+const ProviderNone = "none"
+
+// collectibility grades (kolektibilitas) as reported by SLIK OJK
+const (
+	KolektibilitasTidakAda     = 0 // no credit history
+	KolektibilitasLancar       = 1
+	KolektibilitasDPK          = 2 // dalam perhatian khusus
+	KolektibilitasKurangLancar = 3
+	KolektibilitasDiragukan    = 4
+	KolektibilitasMacet        = 5
+)
+
+// Inquiry identifies the debtor being checked.
+type Inquiry struct {
+	NIK          string    `json:"nik"`
+	NamaLengkap  string    `json:"nama_lengkap"`
+	TanggalLahir time.Time `json:"tanggal_lahir"`
+}
+
+// Report is the bureau answer; Kolektibilitas is the worst grade across the debtor's facilities.
+type Report struct {
+	Provider         string       `json:"provider"`
+	ReferenceID      string       `json:"reference_id"`
+	Kolektibilitas   int          `json:"kolektibilitas"`
+	JumlahFasilitas  int          `json:"jumlah_fasilitas"`
+	TotalOutstanding money.Amount `json:"total_outstanding"`
+}
@@ -0,0 +1,73 @@
+package creditbureau
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const ProviderHTTP = "http"
+
+// HTTPClient posts the inquiry as JSON to BaseURL + "/inquiry" and expects a Report back.
+type HTTPClient struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func NewHTTPClient(baseURL, apiKey string, timeout time.Duration) *HTTPClient {
+	return &HTTPClient{
+		baseURL: strings.TrimRight(strings.TrimSpace(baseURL), "/"),
+		apiKey:  strings.TrimSpace(apiKey),
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *HTTPClient) Check(ctx context.Context, inquiry Inquiry) (*Report, error) {
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("creditbureau: base url is not configured")
+	}
+
+	body, err := json.Marshal(inquiry)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/inquiry", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("creditbureau: inquiry failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("creditbureau: inquiry returned %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	var report Report
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("creditbureau: invalid response: %w", err)
+	}
+	if report.Kolektibilitas < KolektibilitasTidakAda || report.Kolektibilitas > KolektibilitasMacet {
+		return nil, fmt.Errorf("creditbureau: invalid kolektibilitas %d", report.Kolektibilitas)
+	}
+	if report.Provider == "" {
+		report.Provider = ProviderHTTP
+	}
+	return &report, nil
+}
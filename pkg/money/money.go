@@ -0,0 +1,219 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Amount is an exact rupiah amount held in sen (1/100 rupiah), matching the numeric(15,2) columns.
+// Arithmetic that can produce fractions (MulRate, MulRateFrac, MulFrac, Div) rounds half-up to whole rupiah.
+type Amount int64
+
+const senPerRupiah = 100
+
+var Zero Amount
+
+// Rupiah returns a whole rupiah amount.
+func Rupiah(rupiah int64) Amount {
+	return Amount(rupiah * senPerRupiah)
+}
+
+// Parse reads a decimal string ("1500000", "1500000.50", "-12.5"); digits beyond sen are rounded half-up.
+func Parse(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Zero, fmt.Errorf("money: empty amount")
+	}
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return Zero, fmt.Errorf("money: invalid amount %q", value)
+	}
+	return fromRat(r.Mul(r, big.NewRat(senPerRupiah, 1))), nil
+}
+
+// FromFloat converts a float computed elsewhere (e.g. an annuity formula) using its shortest decimal form.
+func FromFloat(value float64) Amount {
+	amount, err := Parse(strconv.FormatFloat(value, 'f', -1, 64))
+	if err != nil {
+		return Zero
+	}
+	return amount
+}
+
+// Sum adds amounts exactly.
+func Sum(amounts ...Amount) Amount {
+	var total Amount
+	for _, amount := range amounts {
+		total += amount
+	}
+	return total
+}
+
+func Min(a, b Amount) Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func Max(a, b Amount) Amount {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func (a Amount) Add(b Amount) Amount { return a + b }
+
+func (a Amount) Sub(b Amount) Amount { return a - b }
+
+func (a Amount) Neg() Amount { return -a }
+
+func (a Amount) Sign() int {
+	switch {
+	case a > 0:
+		return 1
+	case a < 0:
+		return -1
+	}
+	return 0
+}
+
+func (a Amount) IsZero() bool { return a == 0 }
+
+// Sen is the amount in 1/100 rupiah.
+func (a Amount) Sen() int64 { return int64(a) }
+
+// Float64 is for ratios and reporting only; never feed it back into stored amounts without FromFloat.
+func (a Amount) Float64() float64 {
+	return float64(a) / senPerRupiah
+}
+
+// Round rounds half-up (away from zero) to whole rupiah.
+func (a Amount) Round() Amount {
+	sen := int64(a)
+	rest := sen % senPerRupiah
+	rounded := sen - rest
+	switch {
+	case rest >= senPerRupiah/2:
+		rounded += senPerRupiah
+	case rest <= -senPerRupiah/2:
+		rounded -= senPerRupiah
+	}
+	return Amount(rounded)
+}
+
+// MulRate returns percent of the amount, e.g. MulRate(6.5) is 6.5%, rounded to rupiah.
+// The rate is taken in its shortest decimal form so 6.5 is exactly 6.5.
+func (a Amount) MulRate(percent float64) Amount {
+	return a.MulRateFrac(percent, 1, 1)
+}
+
+// MulRateFrac returns percent of the amount over num/den periods (e.g. an annual rate over tenor/12),
+// rounded to rupiah once at the end.
+func (a Amount) MulRateFrac(percent float64, num, den int64) Amount {
+	rate, ok := new(big.Rat).SetString(strconv.FormatFloat(percent, 'f', -1, 64))
+	if !ok || den == 0 {
+		return Zero
+	}
+	rate.Mul(rate, big.NewRat(int64(a), 100))
+	rate.Mul(rate, big.NewRat(num, den))
+	return fromRat(rate).Round()
+}
+
+// MulFrac returns amount x num/den rounded to rupiah; a zero den yields Zero like MulRateFrac.
+func (a Amount) MulFrac(num, den int64) Amount {
+	if den == 0 {
+		return Zero
+	}
+	r := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(num)), big.NewInt(den))
+	return fromRat(r).Round()
+}
+
+// Div splits the amount into n parts rounded to rupiah; callers let the last part absorb the remainder.
+// Div(0) yields Zero instead of panicking.
+func (a Amount) Div(n int64) Amount {
+	return a.MulFrac(1, n)
+}
+
+// String formats the amount with two decimals, e.g. "1500000.50".
+func (a Amount) String() string {
+	sen := int64(a)
+	sign := ""
+	if sen < 0 {
+		sign = "-"
+		sen = -sen
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, sen/senPerRupiah, sen%senPerRupiah)
+}
+
+// MarshalJSON writes an exact JSON number with two decimals.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a quoted decimal string; null leaves the amount unchanged.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		return nil
+	}
+	text = strings.Trim(text, `"`)
+
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Value stores the amount as a decimal string so numeric columns receive it exactly.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+func (a *Amount) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*a = Zero
+		return nil
+	case []byte:
+		return a.scanString(string(value))
+	case string:
+		return a.scanString(value)
+	case int64:
+		*a = Rupiah(value)
+		return nil
+	case float64:
+		*a = FromFloat(value)
+		return nil
+	}
+	return fmt.Errorf("money: cannot scan %T", src)
+}
+
+func (a *Amount) scanString(value string) error {
+	parsed, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// fromRat rounds a sen-denominated rational half-up (away from zero) to whole sen.
+func fromRat(r *big.Rat) Amount {
+	num := new(big.Int).Abs(r.Num())
+	quo, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		quo.Neg(quo)
+	}
+	return Amount(quo.Int64())
+}
@@ -0,0 +1,210 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name string
+		sen  Amount
+		want Amount
+	}{
+		{name: "zero", sen: 0, want: 0},
+		{name: "positive below half", sen: 149, want: 100},
+		{name: "positive half rounds up", sen: 150, want: 200},
+		{name: "positive above half", sen: 199, want: 200},
+		{name: "negative below half", sen: -149, want: -100},
+		{name: "negative half rounds away from zero", sen: -150, want: -200},
+		{name: "negative above half", sen: -199, want: -200},
+		{name: "whole rupiah unchanged", sen: -500, want: -500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sen.Round(); got != tt.want {
+				t.Fatalf("Amount(%d).Round() = %d, want %d", tt.sen, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Amount
+		wantErr bool
+	}{
+		{name: "whole rupiah", value: "1500000", want: 150000000},
+		{name: "sen", value: "1500000.50", want: 150000050},
+		{name: "negative", value: "-12.5", want: -1250},
+		{name: "surrounding spaces", value: "  10.01 ", want: 1001},
+		{name: "sub sen rounds half up", value: "0.005", want: 1},
+		{name: "sub sen below half", value: "0.004", want: 0},
+		{name: "negative sub sen rounds away from zero", value: "-0.005", want: -1},
+		{name: "empty", value: "", wantErr: true},
+		{name: "not a number", value: "12,50", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse(%q) = %d, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Parse(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Amount
+		wantErr bool
+	}{
+		{name: "nil", src: nil, want: Zero},
+		{name: "numeric bytes", src: []byte("19000000.00"), want: 1900000000},
+		{name: "numeric string", src: "875000.25", want: 87500025},
+		{name: "int64 rupiah", src: int64(350000), want: 35000000},
+		{name: "float64", src: 0.1 + 0.2, want: 30},
+		{name: "invalid string", src: "abc", wantErr: true},
+		{name: "unsupported type", src: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Rupiah(1)
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Scan(%v) = %d, want error", tt.src, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) error: %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Scan(%v) = %d, want %d", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	amount := Amount(-150000050)
+	encoded, err := json.Marshal(amount)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(encoded) != "-1500000.50" {
+		t.Fatalf("Marshal = %s, want -1500000.50", encoded)
+	}
+
+	var decoded Amount
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if decoded != amount {
+		t.Fatalf("Unmarshal = %d, want %d", decoded, amount)
+	}
+
+	var quoted Amount
+	if err := json.Unmarshal([]byte(`"1500000.5"`), &quoted); err != nil || quoted != 150000050 {
+		t.Fatalf("Unmarshal quoted = %d (%v), want 150000050", quoted, err)
+	}
+}
+
+func TestMulRateFrac(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Amount
+		percent  float64
+		num, den int64
+		want     Amount
+	}{
+		{name: "flat margin over tenor", amount: Rupiah(19000000), percent: 6.5, num: 24, den: 12, want: Rupiah(2470000)},
+		{name: "decimal rate is exact", amount: Rupiah(100), percent: 6.5, num: 1, den: 1, want: Rupiah(7)},
+		{name: "monthly rate rounds half up", amount: Rupiah(1000), percent: 1.5, num: 1, den: 12, want: Rupiah(1)},
+		{name: "negative amount rounds away from zero", amount: Rupiah(-100), percent: 6.5, num: 1, den: 1, want: Rupiah(-7)},
+		{name: "zero rate", amount: Rupiah(1000), percent: 0, num: 1, den: 1, want: Zero},
+		{name: "zero den", amount: Rupiah(1000), percent: 10, num: 1, den: 0, want: Zero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.MulRateFrac(tt.percent, tt.num, tt.den); got != tt.want {
+				t.Fatalf("MulRateFrac(%v, %d, %d) = %s, want %s", tt.percent, tt.num, tt.den, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Amount
+		n      int64
+		want   Amount
+	}{
+		{name: "even split", amount: Rupiah(900), n: 3, want: Rupiah(300)},
+		{name: "rounds half up", amount: Rupiah(1000), n: 8, want: Rupiah(125)},
+		{name: "rounds down below half", amount: Rupiah(1000), n: 3, want: Rupiah(333)},
+		{name: "rounds up above half", amount: Rupiah(2000), n: 3, want: Rupiah(667)},
+		{name: "negative", amount: Rupiah(-1000), n: 8, want: Rupiah(-125)},
+		{name: "zero parts", amount: Rupiah(1000), n: 0, want: Zero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Div(tt.n); got != tt.want {
+				t.Fatalf("Div(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDivRemainderAbsorption mirrors how schedules split an amount: n-1 equal parts and a last part
+// that absorbs the rounding difference, so the parts always sum back to the total.
+func TestDivRemainderAbsorption(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    Amount
+		n        int64
+		wantLast Amount
+	}{
+		{name: "remainder added to last part", total: Rupiah(1000), n: 3, wantLast: Rupiah(334)},
+		{name: "rounded up parts shrink the last part", total: Rupiah(2000), n: 3, wantLast: Rupiah(666)},
+		{name: "sen remainder", total: Amount(100000050), n: 24, wantLast: Amount(4165950)},
+		{name: "single part", total: Amount(12345), n: 1, wantLast: Amount(12345)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part := tt.total.Div(tt.n)
+			var allocated Amount
+			for i := int64(1); i < tt.n; i++ {
+				allocated = allocated.Add(part)
+			}
+			last := tt.total.Sub(allocated)
+			if last != tt.wantLast {
+				t.Fatalf("last part = %s, want %s", last, tt.wantLast)
+			}
+			if sum := allocated.Add(last); sum != tt.total {
+				t.Fatalf("parts sum to %s, want %s", sum, tt.total)
+			}
+		})
+	}
+}
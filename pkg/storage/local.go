@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Local keeps objects as files under root; signed URLs are served back by this API.
+type Local struct {
+	root   string
+	signer *URLSigner
+}
+
+func NewLocal(root string, signer *URLSigner) *Local {
+	return &Local{root: root, signer: signer}
+}
+
+func (l *Local) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	fullPath, err := l.resolve(key)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(fullPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fullPath)
+}
+
+func (l *Local) Get(_ context.Context, key string) (io.ReadCloser, *Object, error) {
+	fullPath, err := l.resolve(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(fullPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, nil, ErrNotFound
+	}
+
+	return file, &Object{
+		Key:         key,
+		Size:        info.Size(),
+		ContentType: mime.TypeByExtension(filepath.Ext(fullPath)),
+	}, nil
+}
+
+func (l *Local) Delete(_ context.Context, key string) error {
+	fullPath, err := l.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (l *Local) SignedURL(_ context.Context, key string, ttl time.Duration) (string, error) {
+	return l.signer.Sign(key, ttl)
+}
+
+func (l *Local) VerifySignedURL(key, expires, signature string) error {
+	return l.signer.Verify(key, expires, signature)
+}
+
+// resolve maps key to a path under root, refusing keys that escape it.
+func (l *Local) resolve(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	root, err := filepath.Abs(l.root)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, filepath.FromSlash(key)), nil
+}
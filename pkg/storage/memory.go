@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Memory keeps objects in process; it is meant for local runs and tests without a disk or bucket.
+type Memory struct {
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+	signer  *URLSigner
+}
+
+type memoryObject struct {
+	data        []byte
+	contentType string
+}
+
+func NewMemory(signer *URLSigner) *Memory {
+	return &Memory{objects: make(map[string]memoryObject), signer: signer}
+}
+
+func (m *Memory) Put(_ context.Context, key string, body io.Reader, _ int64, contentType string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = memoryObject{data: data, contentType: contentType}
+	return nil
+}
+
+func (m *Memory) Get(_ context.Context, key string) (io.ReadCloser, *Object, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m.mu.RLock()
+	object, ok := m.objects[key]
+	m.mu.RUnlock()
+	if !ok {
+		return nil, nil, ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(object.data)), &Object{
+		Key:         key,
+		Size:        int64(len(object.data)),
+		ContentType: object.contentType,
+	}, nil
+}
+
+func (m *Memory) Delete(_ context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+func (m *Memory) SignedURL(_ context.Context, key string, ttl time.Duration) (string, error) {
+	return m.signer.Sign(key, ttl)
+}
+
+func (m *Memory) VerifySignedURL(key, expires, signature string) error {
+	return m.signer.Verify(key, expires, signature)
+}
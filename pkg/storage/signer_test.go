@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPublicURL = "https://api.example.com/leasing/api/uploads"
+
+func newTestSigner(secret string, now time.Time) *URLSigner {
+	signer := NewURLSigner(testPublicURL+"/", secret)
+	signer.now = func() time.Time { return now }
+	return signer
+}
+
+// parseSignedURL returns the key, expires and signature carried by a URL issued by Sign.
+func parseSignedURL(t *testing.T, signed string) (string, string, string) {
+	t.Helper()
+
+	if !strings.HasPrefix(signed, testPublicURL+"/") {
+		t.Fatalf("signed url %q is not under %s", signed, testPublicURL)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("parse signed url: %v", err)
+	}
+	base, _ := url.Parse(testPublicURL)
+	key := strings.TrimPrefix(u.Path, base.Path+"/")
+	return key, u.Query().Get("expires"), u.Query().Get("signature")
+}
+
+func TestURLSignerSignAndVerify(t *testing.T) {
+	issued := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	signer := newTestSigner("storage-secret", issued)
+
+	signed, err := signer.Sign("contract_documents/12/kartu keluarga.pdf", 5*time.Minute)
+	if err != nil {
+		t.Fatalf("Sign error: %v", err)
+	}
+	if !strings.Contains(signed, "/contract_documents/12/kartu%20keluarga.pdf?") {
+		t.Fatalf("signed url %q does not escape the key", signed)
+	}
+	key, expires, signature := parseSignedURL(t, signed)
+	if expires != "1772359500" {
+		t.Fatalf("expires = %s, want issue time + 5m", expires)
+	}
+
+	tests := []struct {
+		name      string
+		signer    *URLSigner
+		key       string
+		expires   string
+		signature string
+		wantErr   error
+	}{
+		{name: "valid", signer: signer, key: key, expires: expires, signature: signature},
+		{name: "valid until the last second", signer: newTestSigner("storage-secret", issued.Add(5*time.Minute)), key: key, expires: expires, signature: signature},
+		{name: "equivalent key", signer: signer, key: "contract_documents/12/./kartu keluarga.pdf", expires: expires, signature: signature},
+		{name: "tampered key", signer: signer, key: "contract_documents/13/kartu keluarga.pdf", expires: expires, signature: signature, wantErr: ErrSignatureInvalid},
+		{name: "tampered expires", signer: signer, key: key, expires: "1772363100", signature: signature, wantErr: ErrSignatureInvalid},
+		{name: "tampered signature", signer: signer, key: key, expires: expires, signature: strings.Repeat("0", len(signature)), wantErr: ErrSignatureInvalid},
+		{name: "signature not hex", signer: signer, key: key, expires: expires, signature: "not-hex", wantErr: ErrSignatureInvalid},
+		{name: "other signing key", signer: newTestSigner("other-secret", issued), key: key, expires: expires, signature: signature, wantErr: ErrSignatureInvalid},
+		{name: "no signing key", signer: newTestSigner("", issued), key: key, expires: expires, signature: signature, wantErr: ErrSignatureInvalid},
+		{name: "escaping key", signer: signer, key: "../secrets.pdf", expires: expires, signature: signature, wantErr: ErrSignatureInvalid},
+		{name: "expired", signer: newTestSigner("storage-secret", issued.Add(5*time.Minute+time.Second)), key: key, expires: expires, signature: signature, wantErr: ErrURLExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signer.Verify(tt.key, tt.expires, tt.signature)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Verify error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestURLSignerSignRejects(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	if _, err := newTestSigner("", now).Sign("contract_documents/1/ktp.pdf", time.Minute); err == nil {
+		t.Fatal("Sign without a signing key should fail")
+	}
+	if _, err := newTestSigner("storage-secret", now).Sign("../ktp.pdf", time.Minute); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("Sign of an escaping key error = %v, want ErrInvalidKey", err)
+	}
+}
+
+func TestMemorySignedURLRoundTrip(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	store := NewMemory(newTestSigner("storage-secret", now))
+
+	signed, err := store.SignedURL(context.Background(), "contract_documents/1/ktp.pdf", time.Minute)
+	if err != nil {
+		t.Fatalf("SignedURL error: %v", err)
+	}
+	key, expires, signature := parseSignedURL(t, signed)
+	if err := store.VerifySignedURL(key, expires, signature); err != nil {
+		t.Fatalf("VerifySignedURL error: %v", err)
+	}
+	if err := store.VerifySignedURL("contract_documents/1/kk.pdf", expires, signature); !errors.Is(err, ErrSignatureInvalid) {
+		t.Fatalf("VerifySignedURL of another key error = %v, want ErrSignatureInvalid", err)
+	}
+}
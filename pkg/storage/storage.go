@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// providers selectable in STORAGE.PROVIDER
+const (
+	ProviderLocal  = "local"
+	ProviderS3     = "s3"
+	ProviderMemory = "memory"
+)
+
+var (
+	ErrNotFound   = errors.New("storage: object not found")
+	ErrInvalidKey = errors.New("storage: invalid object key")
+)
+
+// Object describes a stored object returned by Get.
+type Object struct {
+	Key         string
+	Size        int64
+	ContentType string
+}
+
+// Storage stores uploaded files under slash-separated keys such as "contract_documents/12/<name>.pdf".
+type Storage interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get returns the object content; the caller closes it.
+	Get(ctx context.Context, key string) (io.ReadCloser, *Object, error)
+	// Delete removes the object; a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that reads the object without other credentials until ttl elapses.
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// SignedURLVerifier is implemented by backends whose signed URLs are served by this API instead of
+// the backend itself (local disk and memory).
+type SignedURLVerifier interface {
+	VerifySignedURL(key, expires, signature string) error
+}
+
+// cleanKey rejects absolute keys and keys escaping the storage root.
+func cleanKey(key string) (string, error) {
+	key = strings.TrimSpace(strings.ReplaceAll(key, "\\", "/"))
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean(key)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
@@ -3,7 +3,15 @@ set -euo pipefail
 
 BASE_URL="${BASE_URL:-http://localhost:8080/leasing/api}"
 CURL_BIN="${CURL_BIN:-curl}"
+AUTH_TOKEN=""
+QA_IDENTIFIER="${QA_IDENTIFIER:-superadmin@leasingbdg.id}"
+# empty QA_PASSWORD: the script sets a random per-run password through SET_PASSWORD_CMD before logging in
+QA_PASSWORD="${QA_PASSWORD:-}"
 JQ_BIN="${JQ_BIN:-jq}"
+REPO_ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+# one-off overdue sweep, run against the same database as the server under test
+SWEEP_CMD="${SWEEP_CMD:-go run . sweep-overdue}"
+SET_PASSWORD_CMD="${SET_PASSWORD_CMD:-go run . set-password}"
 
 command -v "$CURL_BIN" >/dev/null 2>&1 || {
   echo "Error: curl is required" >&2
@@ -58,13 +66,18 @@ api() {
   local url="${BASE_URL}${path}"
 
   local response status body
+  local auth_args=()
+  if [[ -n "$AUTH_TOKEN" ]]; then
+    auth_args=(-H "Authorization: Bearer ${AUTH_TOKEN}")
+  fi
+
   if [[ -n "$payload" ]]; then
-    response="$($CURL_BIN -sS -X "$method" "$url" \
+    response="$($CURL_BIN -sS -X "$method" "$url" ${auth_args[@]+"${auth_args[@]}"} \
       -H 'Content-Type: application/json' \
       -d "$payload" \
       -w $'\n%{http_code}')"
   else
-    response="$($CURL_BIN -sS -X "$method" "$url" -w $'\n%{http_code}')"
+    response="$($CURL_BIN -sS -X "$method" "$url" ${auth_args[@]+"${auth_args[@]}"} -w $'\n%{http_code}')"
   fi
 
   status="${response##*$'\n'}"
@@ -158,6 +171,57 @@ create_and_smoke_crud() {
   printf '%s\n' "$id"
 }
 
+# api_upload posts multipart/form-data with the owner id field, a "file" part and optional extra key=value fields.
+api_upload() {
+  local path="$1"
+  local expected_status="$2"
+  local owner_field="$3"
+  local owner_id="$4"
+  local file_path="$5"
+  shift 5
+  local url="${BASE_URL}${path}"
+
+  local auth_args=()
+  if [[ -n "$AUTH_TOKEN" ]]; then
+    auth_args=(-H "Authorization: Bearer ${AUTH_TOKEN}")
+  fi
+  local extra_args=()
+  local field
+  for field in "$@"; do
+    extra_args+=(-F "$field")
+  done
+
+  mark_coverage "POST" "$path"
+  local response
+  response="$($CURL_BIN -sS -X POST "$url" ${auth_args[@]+"${auth_args[@]}"} \
+    -F "${owner_field}=${owner_id}" \
+    -F "file=@${file_path}" \
+    ${extra_args[@]+"${extra_args[@]}"} \
+    -w $'\n%{http_code}')"
+
+  LAST_STATUS="${response##*$'\n'}"
+  LAST_BODY="${response%$'\n'*}"
+  if [[ "$LAST_STATUS" != "$expected_status" ]]; then
+    printf '[ERROR] POST %s expected=%s got=%s\n' "$path" "$expected_status" "$LAST_STATUS" >&2
+    printf '[ERROR] Response body: %s\n' "$LAST_BODY" >&2
+    exit 1
+  fi
+  printf '[OK] POST %s [%s]\n' "$path" "$LAST_STATUS" >&2
+}
+
+# download_checksum fetches a file route and prints the sha256 of the body.
+download_checksum() {
+  local route_template="$1"
+  local path="$2"
+  local auth_args=()
+  if [[ -n "$AUTH_TOKEN" ]]; then
+    auth_args=(-H "Authorization: Bearer ${AUTH_TOKEN}")
+  fi
+
+  mark_coverage "GET" "$route_template"
+  $CURL_BIN -sSf "${BASE_URL}${path}" ${auth_args[@]+"${auth_args[@]}"} | sha256sum | cut -d' ' -f1
+}
+
 workflow_post() {
   local endpoint="$1"
   local expected_status="$2"
@@ -181,8 +245,86 @@ extract_ids_by_field_match_int() {
     '.data[]? | select(.[$mf] == $mv) | .[$idf]'
 }
 
+# http_status prints the status code only, for requests that are expected to fail.
+http_status() {
+  local method="$1"
+  local path="$2"
+  local token="${3:-}"
+  local payload="${4:-}"
+
+  local args=(-sS -o /dev/null -w '%{http_code}' -X "$method" "${BASE_URL}${path}")
+  if [[ -n "$token" ]]; then
+    args+=(-H "Authorization: Bearer ${token}")
+  fi
+  if [[ -n "$payload" ]]; then
+    args+=(-H 'Content-Type: application/json' -d "$payload")
+  fi
+  $CURL_BIN "${args[@]}"
+}
+
 log "Testing endpoints on ${BASE_URL}"
 
+if [[ -z "$QA_PASSWORD" ]]; then
+  QA_PASSWORD="Qa#${RUN_KEY}$(printf '%05d' $RANDOM)"
+  (cd "$REPO_ROOT" && NEW_PASSWORD="$QA_PASSWORD" $SET_PASSWORD_CMD "$QA_IDENTIFIER") || fail "set-password command failed for ${QA_IDENTIFIER}"
+  log "Set a per-run password for ${QA_IDENTIFIER}"
+fi
+
+# -----------------------------
+# AUTH
+# -----------------------------
+LOGIN_PAYLOAD="$($JQ_BIN -nc --arg identifier "$QA_IDENTIFIER" --arg password "$QA_PASSWORD" '{identifier:$identifier,password:$password}')"
+WRONG_LOGIN_PAYLOAD="$($JQ_BIN -nc --arg identifier "$QA_IDENTIFIER" '{identifier:$identifier,password:"wrong-password"}')"
+WRONG_LOGIN_STATUS="$(http_status POST "/account/auth/login" "" "$WRONG_LOGIN_PAYLOAD")"
+[[ "$WRONG_LOGIN_STATUS" == "401" ]] || fail "Login with a wrong password should be 401, got ${WRONG_LOGIN_STATUS}"
+
+mark_coverage "POST" "/account/auth/login"
+api "POST" "/account/auth/login" "200" "$LOGIN_PAYLOAD"
+FIRST_ACCESS_TOKEN="$(json_get '.data.access_token')"
+FIRST_REFRESH_TOKEN="$(json_get '.data.refresh_token')"
+require_value "$FIRST_ACCESS_TOKEN" "access_token"
+require_value "$FIRST_REFRESH_TOKEN" "refresh_token"
+
+AUTH_TOKEN="$FIRST_ACCESS_TOKEN"
+mark_coverage "GET" "/account/auth/me"
+api "GET" "/account/auth/me" "200"
+AUTH_TOKEN=""
+
+mark_coverage "POST" "/account/auth/refresh"
+api "POST" "/account/auth/refresh" "200" "$($JQ_BIN -nc --arg token "$FIRST_REFRESH_TOKEN" '{refresh_token:$token}')"
+ROTATED_ACCESS_TOKEN="$(json_get '.data.access_token')"
+ROTATED_REFRESH_TOKEN="$(json_get '.data.refresh_token')"
+require_value "$ROTATED_REFRESH_TOKEN" "rotated refresh_token"
+[[ "$ROTATED_REFRESH_TOKEN" != "$FIRST_REFRESH_TOKEN" ]] || fail "Refresh should rotate the refresh token"
+ROTATED_ME_STATUS="$(http_status GET "/account/auth/me" "$ROTATED_ACCESS_TOKEN")"
+[[ "$ROTATED_ME_STATUS" == "200" ]] || fail "Rotated access token should be accepted, got ${ROTATED_ME_STATUS}"
+FIRST_ME_STATUS="$(http_status GET "/account/auth/me" "$FIRST_ACCESS_TOKEN")"
+[[ "$FIRST_ME_STATUS" == "401" ]] || fail "Access token of a rotated session should be rejected, got ${FIRST_ME_STATUS}"
+
+# presenting the rotated token again is treated as theft: every session of the user is revoked
+REUSE_STATUS="$(http_status POST "/account/auth/refresh" "" "$($JQ_BIN -nc --arg token "$FIRST_REFRESH_TOKEN" '{refresh_token:$token}')")"
+[[ "$REUSE_STATUS" == "401" ]] || fail "Reusing a rotated refresh token should be 401, got ${REUSE_STATUS}"
+REVOKED_REFRESH_STATUS="$(http_status POST "/account/auth/refresh" "" "$($JQ_BIN -nc --arg token "$ROTATED_REFRESH_TOKEN" '{refresh_token:$token}')")"
+[[ "$REVOKED_REFRESH_STATUS" == "401" ]] || fail "Refresh token reuse should revoke the newer session too, got ${REVOKED_REFRESH_STATUS}"
+REVOKED_ME_STATUS="$(http_status GET "/account/auth/me" "$ROTATED_ACCESS_TOKEN")"
+[[ "$REVOKED_ME_STATUS" == "401" ]] || fail "Access token of a revoked session should be 401, got ${REVOKED_ME_STATUS}"
+
+api "POST" "/account/auth/login" "200" "$LOGIN_PAYLOAD"
+LOGOUT_ACCESS_TOKEN="$(json_get '.data.access_token')"
+LOGOUT_REFRESH_TOKEN="$(json_get '.data.refresh_token')"
+LOGOUT_PAYLOAD="$($JQ_BIN -nc --arg token "$LOGOUT_REFRESH_TOKEN" '{refresh_token:$token}')"
+mark_coverage "POST" "/account/auth/logout"
+api "POST" "/account/auth/logout" "200" "$LOGOUT_PAYLOAD"
+LOGOUT_ME_STATUS="$(http_status GET "/account/auth/me" "$LOGOUT_ACCESS_TOKEN")"
+[[ "$LOGOUT_ME_STATUS" == "401" ]] || fail "Access token should be rejected after logout, got ${LOGOUT_ME_STATUS}"
+LOGOUT_AGAIN_STATUS="$(http_status POST "/account/auth/logout" "" "$LOGOUT_PAYLOAD")"
+[[ "$LOGOUT_AGAIN_STATUS" == "401" ]] || fail "Second logout with the same refresh token should be 401, got ${LOGOUT_AGAIN_STATUS}"
+
+# session used by the rest of the script
+api "POST" "/account/auth/login" "200" "$LOGIN_PAYLOAD"
+AUTH_TOKEN="$(json_get '.data.access_token')"
+require_value "$AUTH_TOKEN" "access_token"
+
 # quick connectivity check
 api "GET" "/account/roles" "200"
 
@@ -250,6 +392,27 @@ USER_ROLE_UPDATE="$($JQ_BIN -nc \
   '{user_id:$user_id,role_id:$role_id,assigned_by:$assigned_by}')"
 USER_ROLE_ID="$(create_and_smoke_crud "/account/user_roles" "user_role_id" "$USER_ROLE_CREATE" "$USER_ROLE_UPDATE")"
 
+# a role without manage_user is rejected; granting it takes effect once the grant is committed
+LIMITED_LOGIN_PAYLOAD="$($JQ_BIN -nc --arg identifier "$USERNAME" '{identifier:$identifier,password:"Pass12345!updated"}')"
+api "POST" "/account/auth/login" "200" "$LIMITED_LOGIN_PAYLOAD"
+LIMITED_ACCESS_TOKEN="$(json_get '.data.access_token')"
+require_value "$LIMITED_ACCESS_TOKEN" "limited access_token"
+FORBIDDEN_STATUS="$(http_status GET "/account/roles" "$LIMITED_ACCESS_TOKEN")"
+[[ "$FORBIDDEN_STATUS" == "403" ]] || fail "Role without manage_user should get 403, got ${FORBIDDEN_STATUS}"
+
+api "GET" "/account/permissions?permission_type=manage_user" "200"
+MANAGE_USER_PERMISSION_ID="$(json_get '.data[0].permission_id')"
+require_value "$MANAGE_USER_PERMISSION_ID" "manage_user permission_id"
+api "POST" "/account/role_permission" "201" "$($JQ_BIN -nc --argjson role_id "$ROLE_ID" --argjson permission_id "$MANAGE_USER_PERMISSION_ID" '{role_id:$role_id,permission_id:$permission_id}')"
+GRANT_ROLE_PERMISSION_ID="$(json_get '.data.role_permission_id')"
+require_value "$GRANT_ROLE_PERMISSION_ID" "granted role_permission_id"
+GRANTED_STATUS="$(http_status GET "/account/roles" "$LIMITED_ACCESS_TOKEN")"
+[[ "$GRANTED_STATUS" == "200" ]] || fail "Granted manage_user should allow /account/roles, got ${GRANTED_STATUS}"
+
+api "DELETE" "/account/role_permission/${GRANT_ROLE_PERMISSION_ID}" "200"
+REVOKED_STATUS="$(http_status GET "/account/roles" "$LIMITED_ACCESS_TOKEN")"
+[[ "$REVOKED_STATUS" == "403" ]] || fail "Revoked manage_user should get 403 again, got ${REVOKED_STATUS}"
+
 # -----------------------------
 # MST
 # -----------------------------
@@ -331,6 +494,10 @@ CUSTOMER_CREATE="$($JQ_BIN -nc \
 CUSTOMER_UPDATE="$($JQ_BIN -nc --argjson location_id "$LOCATION_ID" '{nama_lengkap:"Customer QA Updated",pekerjaan:"Supervisor",salary:9000000,location_id:$location_id}')"
 CUSTOMER_ID="$(create_and_smoke_crud "/dealer/customer" "customer_id" "$CUSTOMER_CREATE" "$CUSTOMER_UPDATE")"
 
+BLACKLIST_CREATE="$($JQ_BIN -nc --arg nik "$CUSTOMER_NIK" --argjson customer_id "$CUSTOMER_ID" '{nik:$nik,reason:"QA blacklist",expires_at:"2099-12-31T00:00:00Z",customer_id:$customer_id}')"
+BLACKLIST_UPDATE="$($JQ_BIN -nc '{reason:"QA blacklist updated"}')"
+BLACKLIST_ID="$(create_and_smoke_crud "/dealer/customer_blacklist" "blacklist_id" "$BLACKLIST_CREATE" "$BLACKLIST_UPDATE")"
+
 # workflow dedicated motor + customer
 WF_MOTOR_NORANGKA="NRG${RUN_KEY}B"
 WF_MOTOR_NOMESIN="NMS${RUN_KEY}B"
@@ -345,7 +512,7 @@ create_resource "/dealer/motors" "$WF_MOTOR_CREATE"
 WF_MOTOR_ID="$(json_get '.data.motor_id')"
 require_value "$WF_MOTOR_ID" "wf_motor_id"
 
-WF_CUSTOMER_NIK="2${RUN_KEY:0:15}"
+WF_CUSTOMER_NIK="2${RUN_KEY:0:14}1" # fake SLIK: last digit 1 = lancar
 WF_CUSTOMER_PHONE="07${RUN_KEY:0:10}"
 WF_CUSTOMER_EMAIL="wf.${RUN_KEY}@example.com"
 WF_CUSTOMER_CREATE="$($JQ_BIN -nc \
@@ -365,10 +532,14 @@ require_value "$WF_CUSTOMER_ID" "wf_customer_id"
 PRODUCT_CODE="KP${RUN_KEY:0:10}"
 PRODUCT_CODE_UPD="KP${RUN_KEY:0:8}UP"
 
-LEASING_PRODUCT_CREATE="$($JQ_BIN -nc --arg kode "$PRODUCT_CODE" '{kode_produk:$kode,nama_produk:"Produk QA",tenor_bulan:24,dp_persen_min:20,dp_persen_max:35,bunga_flat:6.5,admin_fee:350000,asuransi:true}')"
-LEASING_PRODUCT_UPDATE="$($JQ_BIN -nc --arg kode "$PRODUCT_CODE_UPD" '{kode_produk:$kode,nama_produk:"Produk QA Updated",tenor_bulan:24,dp_persen_min:20,dp_persen_max:35,bunga_flat:6.8,admin_fee:360000,asuransi:true}')"
+LEASING_PRODUCT_CREATE="$($JQ_BIN -nc --arg kode "$PRODUCT_CODE" '{kode_produk:$kode,nama_produk:"Produk QA",tenor_bulan:24,dp_persen_min:20,dp_persen_max:35,bunga_flat:6.5,admin_fee:350000,asuransi:true,asuransi_dibiayai:true,biaya_fidusia:250000,denda_tipe:"daily_rate",denda_nilai:0.1,denda_grace_hari:3}')"
+LEASING_PRODUCT_UPDATE="$($JQ_BIN -nc --arg kode "$PRODUCT_CODE_UPD" '{kode_produk:$kode,nama_produk:"Produk QA Updated",tenor_bulan:24,dp_persen_min:20,dp_persen_max:35,bunga_flat:6.8,admin_fee:360000,asuransi:true,asuransi_dibiayai:true,biaya_fidusia:250000,denda_tipe:"daily_rate",denda_nilai:0.1,denda_grace_hari:3}')"
 PRODUCT_ID="$(create_and_smoke_crud "/leasing/leasing_product" "product_id" "$LEASING_PRODUCT_CREATE" "$LEASING_PRODUCT_UPDATE")"
 
+INSURANCE_RATE_CREATE="$($JQ_BIN -nc --argjson moty_id "$MOTY_ID" '{tenor_bulan:24,rate_persen:3.75,moty_id:$moty_id}')"
+INSURANCE_RATE_UPDATE="$($JQ_BIN -nc '{rate_persen:3.8}')"
+RATE_ID="$(create_and_smoke_crud "/leasing/insurance_rates" "rate_id" "$INSURANCE_RATE_CREATE" "$INSURANCE_RATE_UPDATE")"
+
 CONTRACT_NUM="CTR-${RUN_KEY}"
 CONTRACT_NUM_UPD="CTR-${RUN_KEY}-UPD"
 LEASING_CONTRACT_CREATE="$($JQ_BIN -nc \
@@ -382,6 +553,20 @@ LEASING_CONTRACT_CREATE="$($JQ_BIN -nc \
 LEASING_CONTRACT_UPDATE="$($JQ_BIN -nc --arg contract_number "$CONTRACT_NUM_UPD" '{contract_number:$contract_number,status:"approved"}')"
 CONTRACT_ID="$(create_and_smoke_crud "/leasing/leasing_contract" "contract_id" "$LEASING_CONTRACT_CREATE" "$LEASING_CONTRACT_UPDATE")"
 
+# an active contract without installment rows cannot take payments (and so is never settled as paid_off)
+NO_SCHEDULE_CONTRACT_CREATE="$(printf '%s' "$LEASING_CONTRACT_CREATE" | $JQ_BIN -c --arg contract_number "${CONTRACT_NUM}-NS" '.contract_number = $contract_number | .status = "active"')"
+api "POST" "/leasing/leasing_contract" "201" "$NO_SCHEDULE_CONTRACT_CREATE"
+NO_SCHEDULE_CONTRACT_ID="$(json_get '.data.contract_id')"
+require_value "$NO_SCHEDULE_CONTRACT_ID" "no schedule contract_id"
+NO_SCHEDULE_POSTING="$($JQ_BIN -nc --argjson contract_id "$NO_SCHEDULE_CONTRACT_ID" --arg nomor_bukti "PAY-NS-${RUN_KEY}" --arg tanggal_bayar "$DATE_BAYAR" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:100000,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+NO_SCHEDULE_STATUS="$(http_status POST "/payment/payments/posting" "$AUTH_TOKEN" "$NO_SCHEDULE_POSTING")"
+[[ "$NO_SCHEDULE_STATUS" == "400" ]] || fail "Posting against a contract without schedule should be 400, got ${NO_SCHEDULE_STATUS}"
+api "GET" "/leasing/leasing_contract/${NO_SCHEDULE_CONTRACT_ID}" "200"
+[[ "$(json_get '.data.status')" == "active" ]] || fail "Contract without schedule should stay active"
+NO_SCHEDULE_QUOTE_STATUS="$(http_status POST "/leasing/workflow/payoff-quote" "$AUTH_TOKEN" "$($JQ_BIN -nc --argjson contract_id "$NO_SCHEDULE_CONTRACT_ID" '{contract_id:$contract_id}')")"
+[[ "$NO_SCHEDULE_QUOTE_STATUS" == "400" ]] || fail "Payoff quote without schedule should be 400, got ${NO_SCHEDULE_QUOTE_STATUS}"
+api "DELETE" "/leasing/leasing_contract/${NO_SCHEDULE_CONTRACT_ID}" "200"
+
 LEASING_TASK_CREATE="$($JQ_BIN -nc \
   --arg startdate "$DATE_START" \
   --arg enddate "$DATE_END" \
@@ -417,6 +602,15 @@ PAYMENT_ID="$(create_and_smoke_crud "/payment/payments" "payment_id" "$PAYMENT_C
 # -----------------------------
 # LEASING WORKFLOW
 # -----------------------------
+BLACKLISTED_SUBMIT_PAYLOAD="$($JQ_BIN -nc \
+  --argjson customer_id "$CUSTOMER_ID" \
+  --argjson motor_id "$WF_MOTOR_ID" \
+  --argjson product_id "$PRODUCT_ID" \
+  '{customer_id:$customer_id,motor_id:$motor_id,product_id:$product_id,dp_dibayar:7000000,tenor_bulan:24}')"
+workflow_post "/leasing/workflow/submit-application" "422" "$BLACKLISTED_SUBMIT_PAYLOAD"
+BLACKLISTED_REASONS="$(json_get '.error.details.reasons | join(",")')"
+[[ "$BLACKLISTED_REASONS" == *BLACKLISTED* ]] || fail "Blacklisted customer submission should report BLACKLISTED, got $BLACKLISTED_REASONS"
+
 WF_SUBMIT_PAYLOAD="$($JQ_BIN -nc \
   --argjson customer_id "$WF_CUSTOMER_ID" \
   --argjson motor_id "$WF_MOTOR_ID" \
@@ -426,9 +620,127 @@ WF_SUBMIT_PAYLOAD="$($JQ_BIN -nc \
 workflow_post "/leasing/workflow/submit-application" "201" "$WF_SUBMIT_PAYLOAD"
 WF_CONTRACT_ID="$(json_get '.data.contract_id')"
 require_value "$WF_CONTRACT_ID" "wf_contract_id"
-
-WF_AUTOSCORING_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" '{contract_id:$contract_id,auto_approved:true,manual_review_ready:false,manual_approved:false,note:"auto-approved by qa script"}')"
+WF_SUBMITTED_FEES="$(json_get '[.data.admin_fee, .data.asuransi_premi, .data.biaya_fidusia, .data.biaya_dibiayai, .data.biaya_dimuka] | tostring')"
+WF_INITIAL_AMOUNT="$(json_get '.data.dp_dibayar + .data.biaya_dimuka')"
+require_value "$WF_INITIAL_AMOUNT" "wf_initial_amount"
+
+# structured list filters (brackets URL-encoded so curl does not glob them)
+api GET "/leasing/leasing_contract?customer_id=${WF_CUSTOMER_ID}&request_date%5Bgte%5D=2000-01-01&status%5Bin%5D=draft,approved" "200"
+FILTERED_CONTRACT_IDS="$(json_get '[.data[].contract_id] | map(tostring) | join(",")')"
+[[ ",${FILTERED_CONTRACT_IDS}," == *",${WF_CONTRACT_ID},"* ]] || fail "Filtered contract list should include the workflow contract"
+[[ "$(json_get "[.data[] | select(.customer_id != ${WF_CUSTOMER_ID})] | length")" == "0" ]] || fail "customer_id filter returned other customers"
+FILTER_STATUS="$($CURL_BIN -sS -o /dev/null -w '%{http_code}' -H "Authorization: Bearer ${AUTH_TOKEN}" \
+  "${BASE_URL}/leasing/leasing_contract?bogus_field=1")"
+[[ "$FILTER_STATUS" == "400" ]] || fail "Unknown list filter should be rejected, got ${FILTER_STATUS}"
+
+# per-resource search and sort whitelists
+api GET "/dealer/customer?search=${WF_CUSTOMER_NIK}&sort_by=nama_lengkap&sort_order=desc" "200"
+[[ "$(json_get "[.data[] | select(.customer_id == ${WF_CUSTOMER_ID})] | length")" == "1" ]] || fail "Customer search by NIK should find the workflow customer"
+SORT_BODY="$($CURL_BIN -sS -H "Authorization: Bearer ${AUTH_TOKEN}" "${BASE_URL}/dealer/customer?sort_by=password")"
+[[ "$(printf '%s' "$SORT_BODY" | $JQ_BIN -r '.error.details.allowed | index("nama_lengkap") != null')" == "true" ]] \
+  || fail "Unknown sort field should be rejected with the allowed list: ${SORT_BODY}"
+
+# cursor (keyset) paging: follow next_cursor, total only on request
+api GET "/account/permissions?cursor=&limit=1&sort_by=permission_id" "200"
+[[ "$(json_get '.meta.total_items // "none"')" == "none" ]] || fail "Cursor list should skip the total unless include_total=true"
+[[ "$(json_get '.meta.has_more')" == "true" ]] || fail "Cursor list of permissions should have more than one page"
+CURSOR_FIRST_ID="$(json_get '.data[0].permission_id')"
+CURSOR_NEXT="$(json_get '.meta.next_cursor')"
+require_value "$CURSOR_NEXT" "next_cursor"
+api GET "/account/permissions?cursor=${CURSOR_NEXT}&limit=1&sort_by=permission_id&include_total=true" "200"
+(( "$(json_get '.data[0].permission_id')" > CURSOR_FIRST_ID )) || fail "Second cursor page should continue after the first"
+require_value "$(json_get '.meta.total_items')" "cursor total_items"
+CURSOR_STATUS="$($CURL_BIN -sS -o /dev/null -w '%{http_code}' -H "Authorization: Bearer ${AUTH_TOKEN}" \
+  "${BASE_URL}/account/permissions?cursor=${CURSOR_NEXT}&sort_by=permission_type")"
+[[ "$CURSOR_STATUS" == "400" ]] || fail "Cursor reused with another sort should be rejected, got ${CURSOR_STATUS}"
+
+# sparse fieldsets and secret columns
+api GET "/account/users?fields=username,email&limit=5" "200"
+[[ "$(json_get '[.data[] | keys[] | ascii_downcase | select(. != "username" and . != "email" and . != "userid" and . != "user_id")] | length')" == "0" ]] \
+  || fail "fields=username,email should only return the requested columns and the primary key"
+api GET "/account/users/${USER_ID}" "200"
+[[ "$(json_get '[.data | keys[] | ascii_downcase | select(. == "password" or . == "pin_key" or . == "pinkey")] | length')" == "0" ]] \
+  || fail "User detail must never serialize password or pin key"
+FIELDS_BODY="$($CURL_BIN -sS -H "Authorization: Bearer ${AUTH_TOKEN}" "${BASE_URL}/account/users?fields=password")"
+[[ "$(printf '%s' "$FIELDS_BODY" | $JQ_BIN -r '.error.details.allowed | (index("username") != null) and (index("password") == null)')" == "true" ]] \
+  || fail "Selecting a secret column should be rejected with the allowed list: ${FIELDS_BODY}"
+
+# preload whitelist and depth limit
+api GET "/leasing/leasing_contract/${WF_CONTRACT_ID}?preload=Customer,Motor,Product" "200"
+[[ "$(json_get '.data.customer.customer_id')" == "$WF_CUSTOMER_ID" ]] || fail "Preloaded contract should carry its customer"
+PRELOAD_BODY="$($CURL_BIN -sS -H "Authorization: Bearer ${AUTH_TOKEN}" \
+  "${BASE_URL}/leasing/leasing_contract?preload=Customer.LeasingContracts.Payments")"
+[[ "$(printf '%s' "$PRELOAD_BODY" | $JQ_BIN -r '.error.details.allowed | index("Customer") != null')" == "true" ]] \
+  || fail "Too-deep preload should be rejected with the allowed relations: ${PRELOAD_BODY}"
+PRELOAD_STATUS="$($CURL_BIN -sS -o /dev/null -w '%{http_code}' -H "Authorization: Bearer ${AUTH_TOKEN}" \
+  "${BASE_URL}/dealer/motors?preload=Bogus")"
+[[ "$PRELOAD_STATUS" == "400" ]] || fail "Unknown preload relation should be rejected, got ${PRELOAD_STATUS}"
+
+UPLOAD_DIR="$(mktemp -d)"
+printf '%%PDF-1.4\n%% QA %s\n%%%%EOF\n' "$RUN_KEY" >"${UPLOAD_DIR}/ktp.pdf"
+printf '\x89PNG\r\n\x1a\n%s' "$RUN_KEY" >"${UPLOAD_DIR}/unit.png"
+printf 'MZ-not-a-document-%s' "$RUN_KEY" >"${UPLOAD_DIR}/fake.pdf"
+
+api_upload "/leasing/leasing_contract_documents/upload" "201" "contract_id" "$WF_CONTRACT_ID" "${UPLOAD_DIR}/ktp.pdf"
+UPLOAD_DOC_ID="$(json_get '.data.loc_id')"
+require_value "$UPLOAD_DOC_ID" "uploaded contract document id"
+[[ "$(json_get '.data.file_type')" == "pdf" ]] || fail "Uploaded contract document should be typed pdf"
+UPLOAD_DOC_CHECKSUM="$(json_get '.data.checksum')"
+DOWNLOAD_DOC_CHECKSUM="$(download_checksum "/leasing/leasing_contract_documents/:id/download" "/leasing/leasing_contract_documents/${UPLOAD_DOC_ID}/download")"
+[[ "$UPLOAD_DOC_CHECKSUM" == "$DOWNLOAD_DOC_CHECKSUM" ]] || fail "Downloaded contract document checksum mismatch"
+api_upload "/leasing/leasing_contract_documents/upload" "415" "contract_id" "$WF_CONTRACT_ID" "${UPLOAD_DIR}/fake.pdf"
+
+api_upload "/dealer/motor_assets/upload" "201" "motor_id" "$MOTOR_ID" "${UPLOAD_DIR}/unit.png"
+UPLOAD_ASSET_ID="$(json_get '.data.moas_id')"
+require_value "$UPLOAD_ASSET_ID" "uploaded motor asset id"
+UPLOAD_ASSET_CHECKSUM="$(json_get '.data.checksum')"
+DOWNLOAD_ASSET_CHECKSUM="$(download_checksum "/dealer/motor_assets/:id/download" "/dealer/motor_assets/${UPLOAD_ASSET_ID}/download")"
+[[ "$UPLOAD_ASSET_CHECKSUM" == "$DOWNLOAD_ASSET_CHECKSUM" ]] || fail "Downloaded motor asset checksum mismatch"
+
+# KTP/KK are only readable through short-lived signed URLs
+api_upload "/leasing/leasing_contract_documents/upload" "201" "contract_id" "$WF_CONTRACT_ID" "${UPLOAD_DIR}/ktp.pdf" "doc_type=ktp"
+UPLOAD_KTP_ID="$(json_get '.data.loc_id')"
+require_value "$UPLOAD_KTP_ID" "uploaded ktp document id"
+[[ "$(json_get '.data.doc_type')" == "ktp" ]] || fail "Uploaded ktp document should keep doc_type ktp"
+UPLOAD_KTP_CHECKSUM="$(json_get '.data.checksum')"
+mark_coverage "GET" "/leasing/leasing_contract_documents/:id/download"
+KTP_DOWNLOAD_STATUS="$($CURL_BIN -sS -o /dev/null -w '%{http_code}' -H "Authorization: Bearer ${AUTH_TOKEN}" \
+  "${BASE_URL}/leasing/leasing_contract_documents/${UPLOAD_KTP_ID}/download")"
+[[ "$KTP_DOWNLOAD_STATUS" == "307" ]] || fail "KTP download should redirect to a signed URL, got ${KTP_DOWNLOAD_STATUS}"
+
+mark_coverage "GET" "/leasing/leasing_contract_documents/:id/signed-url"
+api GET "/leasing/leasing_contract_documents/${UPLOAD_KTP_ID}/signed-url" "200"
+KTP_SIGNED_URL="$(json_get '.data.url')"
+require_value "$KTP_SIGNED_URL" "ktp signed url"
+require_value "$(json_get '.data.expires_at')" "ktp signed url expiry"
+# local/memory storage serves signed URLs under {BASE_URL}/uploads; PUBLIC_URL may name another host
+if [[ "$KTP_SIGNED_URL" == *"/uploads/"* && "$KTP_SIGNED_URL" != *"X-Amz-Signature"* ]]; then
+  KTP_SIGNED_URL="${BASE_URL}/uploads/${KTP_SIGNED_URL#*/uploads/}"
+fi
+SIGNED_KTP_CHECKSUM="$($CURL_BIN -sSf "$KTP_SIGNED_URL" | sha256sum | cut -d' ' -f1)"
+[[ "$UPLOAD_KTP_CHECKSUM" == "$SIGNED_KTP_CHECKSUM" ]] || fail "Signed KTP download checksum mismatch"
+if [[ "$KTP_SIGNED_URL" != *"X-Amz-Signature"* ]]; then
+  TAMPERED_STATUS="$($CURL_BIN -sS -o /dev/null -w '%{http_code}' "${KTP_SIGNED_URL%signature=*}signature=00")"
+  [[ "$TAMPERED_STATUS" == "403" ]] || fail "Tampered signed URL should be rejected, got ${TAMPERED_STATUS}"
+fi
+rm -rf "$UPLOAD_DIR"
+
+mark_coverage "POST" "/leasing/simulate"
+api "POST" "/leasing/simulate" "200" "$($JQ_BIN -nc --argjson motor_id "$WF_MOTOR_ID" '{motor_id:$motor_id}')"
+WF_SIMULATION_OPTIONS="$(json_get '.data.products | length')"
+[[ "$WF_SIMULATION_OPTIONS" -gt 0 ]] || fail "Simulation should return at least one product"
+WF_SIMULATION_EFFECTIVE="$(json_get '.data.products[0].bunga_efektif')"
+require_value "$WF_SIMULATION_EFFECTIVE" "simulation bunga_efektif"
+
+# no insurance rate exists for a 7 month tenor: those products are listed as unavailable instead of failing the call
+api "POST" "/leasing/simulate" "200" "$($JQ_BIN -nc --argjson motor_id "$WF_MOTOR_ID" '{motor_id:$motor_id,tenor_bulan:7}')"
+WF_SIMULATION_UNAVAILABLE="$(json_get '.data.unavailable_products | length')"
+[[ "$WF_SIMULATION_UNAVAILABLE" -gt 0 ]] || fail "Products without an insurance rate should be listed as unavailable"
+
+WF_AUTOSCORING_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" '{contract_id:$contract_id,auto:true,note:"scored by qa script"}')"
 workflow_post "/leasing/workflow/auto-scoring" "200" "$WF_AUTOSCORING_PAYLOAD"
+WF_SCORING_DECISION="$(json_get '.data.decision')"
+[[ "$WF_SCORING_DECISION" == "approve" ]] || fail "Auto scoring should approve the workflow customer, got $WF_SCORING_DECISION"
 
 WF_SURVEY_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" '{contract_id:$contract_id,decision:"approve",note:"survey passed"}')"
 workflow_post "/leasing/workflow/survey" "200" "$WF_SURVEY_PAYLOAD"
@@ -439,8 +751,23 @@ workflow_post "/leasing/workflow/final-approval" "200" "$WF_FINAL_APPROVAL_PAYLO
 WF_AKAD_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg akad_date "$DATE_AKAD" --arg tanggal_mulai_cicil "$DATE_CICIL" '{contract_id:$contract_id,akad_date:$akad_date,tanggal_mulai_cicil:$tanggal_mulai_cicil,generate_contract_code:true}')"
 workflow_post "/leasing/workflow/akad" "200" "$WF_AKAD_PAYLOAD"
 
+# schedule is generated from the interest terms frozen on the contract and reconciles to its totals
+get_resource "/leasing/leasing_contract" "$WF_CONTRACT_ID"
+WF_TENOR="$(json_get '.data.tenor_bulan')"
+WF_POKOK_SEN="$(json_get '.data.pokok_pinjaman * 100 | round')"
+WF_TOTAL_SEN="$(json_get '.data.total_pinjaman * 100 | round')"
+WF_METODE_BUNGA="$(json_get '.data.metode_bunga')"
+[[ "$WF_METODE_BUNGA" == "flat" ]] || fail "Contract should keep the product metode_bunga, got ${WF_METODE_BUNGA}"
+api GET "/payment/payment_schedule?contract_id=${WF_CONTRACT_ID}&limit=100" "200"
+WF_SCHEDULE_ROWS="$(json_get '.data | length')"
+WF_SCHEDULE_POKOK_SEN="$(json_get '[.data[].pokok * 100 | round] | add')"
+WF_SCHEDULE_TOTAL_SEN="$(json_get '[.data[].total_tagihan * 100 | round] | add')"
+[[ "$WF_SCHEDULE_ROWS" == "$WF_TENOR" ]] || fail "Schedule should have ${WF_TENOR} rows, got ${WF_SCHEDULE_ROWS}"
+[[ "$WF_SCHEDULE_POKOK_SEN" == "$WF_POKOK_SEN" ]] || fail "Schedule pokok sums to ${WF_SCHEDULE_POKOK_SEN} sen, expected ${WF_POKOK_SEN}"
+[[ "$WF_SCHEDULE_TOTAL_SEN" == "$WF_TOTAL_SEN" ]] || fail "Schedule total_tagihan sums to ${WF_SCHEDULE_TOTAL_SEN} sen, expected ${WF_TOTAL_SEN}"
+
 WF_PAYMENT_NUMBER="PAY-WF-${RUN_KEY}"
-WF_INITIAL_PAYMENT_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg nomor_bukti "$WF_PAYMENT_NUMBER" --arg tanggal_bayar "$DATE_BAYAR" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:7000000,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+WF_INITIAL_PAYMENT_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg nomor_bukti "$WF_PAYMENT_NUMBER" --arg tanggal_bayar "$DATE_BAYAR" --argjson jumlah_bayar "$WF_INITIAL_AMOUNT" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:$jumlah_bayar,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
 workflow_post "/leasing/workflow/initial-payment" "200" "$WF_INITIAL_PAYMENT_PAYLOAD"
 
 WF_DEALER_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" '{contract_id:$contract_id,unit_ready_stock:true,estimated_indent_week:0,note:"stock ready"}')"
@@ -451,6 +778,93 @@ workflow_post "/leasing/workflow/delivery" "200" "$WF_DELIVERY_PAYLOAD"
 
 # ensure workflow contract detail endpoint still works
 get_resource "/leasing/leasing_contract" "$WF_CONTRACT_ID"
+WF_DELIVERED_FEES="$(json_get '[.data.admin_fee, .data.asuransi_premi, .data.biaya_fidusia, .data.biaya_dibiayai, .data.biaya_dimuka] | tostring')"
+[[ "$WF_DELIVERED_FEES" == "$WF_SUBMITTED_FEES" ]] || fail "Fees frozen at submission changed: ${WF_SUBMITTED_FEES} -> ${WF_DELIVERED_FEES}"
+
+mark_coverage "GET" "/leasing/workflow/contract-states"
+api "GET" "/leasing/workflow/contract-states" "200"
+mark_coverage "GET" "/leasing/workflow/contract-states/:id"
+api "GET" "/leasing/workflow/contract-states/${WF_CONTRACT_ID}" "200"
+WF_CONTRACT_STATUS="$(json_get '.data.status')"
+[[ "$WF_CONTRACT_STATUS" == "active" ]] || fail "Workflow contract should be active, got ${WF_CONTRACT_STATUS}"
+
+mark_coverage "GET" "/leasing/leasing_contract/:id/history"
+api "GET" "/leasing/leasing_contract/${WF_CONTRACT_ID}/history" "200"
+WF_HISTORY_LAST="$(json_get '.data[-1].to_status')"
+[[ "$WF_HISTORY_LAST" == "active" ]] || fail "Last status history entry should be active, got ${WF_HISTORY_LAST}"
+
+# a partial payment leaves the contract active
+WF_PARTIAL_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg nomor_bukti "PAY-PART-${RUN_KEY}" --arg tanggal_bayar "$DATE_DELIVERY" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:100000,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+workflow_post "/payment/payments/posting" "201" "$WF_PARTIAL_PAYLOAD"
+WF_PARTIAL_STATUS="$(json_get '.data.contract_status')"
+[[ "$WF_PARTIAL_STATUS" == "active" ]] || fail "Partial payment should keep the contract active, got ${WF_PARTIAL_STATUS}"
+api "GET" "/leasing/workflow/contract-states/${WF_CONTRACT_ID}" "200"
+[[ "$(json_get '.data.status')" == "active" ]] || fail "Contract should stay active after a partial payment"
+
+WF_POSTING_NUMBER="PAY-POST-${RUN_KEY}"
+WF_POSTING_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg nomor_bukti "$WF_POSTING_NUMBER" --arg tanggal_bayar "$DATE_DELIVERY" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:1000000,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+workflow_post "/payment/payments/posting" "201" "$WF_POSTING_PAYLOAD"
+WF_POSTING_ALLOCATIONS="$(json_get '.data.allocations | length')"
+[[ "$WF_POSTING_ALLOCATIONS" -gt 0 ]] || fail "Payment posting should produce allocation lines"
+[[ "$(json_get '.data.contract_status')" == "active" ]] || fail "Contract with unpaid installments should stay active"
+
+mark_coverage "GET" "/payment/penalties/:contract_id"
+api "GET" "/payment/penalties/${WF_CONTRACT_ID}?as_of=2026-12-31" "200"
+WF_PENALTY_ROWS="$(json_get '.data.installments | length')"
+[[ "$WF_PENALTY_ROWS" -gt 0 ]] || fail "Penalty breakdown should list installments"
+
+# the CLI sweep flags the past-due installments (tanggal_mulai_cicil is in the past) and moves the contract to late
+SWEEP_OUTPUT=""
+for attempt in 1 2 3; do
+  SWEEP_OUTPUT="$(cd "$REPO_ROOT" && $SWEEP_CMD)" || fail "sweep-overdue command failed"
+  [[ "$(printf '%s' "$SWEEP_OUTPUT" | $JQ_BIN -r '.skipped')" == "false" ]] && break
+  log "sweep-overdue skipped (lock held by another run), retry ${attempt}"
+  sleep 1
+done
+[[ "$(printf '%s' "$SWEEP_OUTPUT" | $JQ_BIN -r '.skipped')" == "false" ]] || fail "sweep-overdue was skipped: ${SWEEP_OUTPUT}"
+printf '[OK] sweep-overdue %s\n' "$SWEEP_OUTPUT" >&2
+
+api "GET" "/leasing/workflow/contract-states/${WF_CONTRACT_ID}" "200"
+WF_SWEPT_STATUS="$(json_get '.data.status')"
+[[ "$WF_SWEPT_STATUS" == "late" ]] || fail "Sweep should move the contract to late, got ${WF_SWEPT_STATUS}"
+api "GET" "/payment/penalties/${WF_CONTRACT_ID}" "200"
+WF_OVERDUE_ROWS="$(json_get '[.data.installments[] | select(.status_pembayaran == "overdue")] | length')"
+[[ "$WF_OVERDUE_ROWS" -gt 0 ]] || fail "Sweep should flag past-due installments as overdue"
+WF_DENDA_SEN="$(json_get '.data.total_denda_sisa * 100 | round')"
+[[ "$WF_DENDA_SEN" -gt 0 ]] || fail "Overdue installments should accrue denda, got ${WF_DENDA_SEN} sen"
+
+# quotes are issued for today or later; a backdated quote_date could price away accrued denda
+WF_BACKDATED_QUOTE_STATUS="$(http_status POST "/leasing/workflow/payoff-quote" "$AUTH_TOKEN" "$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --arg quote_date "$DATE_DELIVERY" '{contract_id:$contract_id,quote_date:$quote_date}')")"
+[[ "$WF_BACKDATED_QUOTE_STATUS" == "400" ]] || fail "Backdated payoff quote should be 400, got ${WF_BACKDATED_QUOTE_STATUS}"
+
+WF_PAYOFF_QUOTE_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" '{contract_id:$contract_id}')"
+workflow_post "/leasing/workflow/payoff-quote" "200" "$WF_PAYOFF_QUOTE_PAYLOAD"
+WF_PAYOFF_QUOTE_ID="$(json_get '.data.quote_id')"
+require_value "$WF_PAYOFF_QUOTE_ID" "wf_payoff_quote_id"
+WF_PAYOFF_AMOUNT="$(json_get '.data.payoff_amount')"
+require_value "$WF_PAYOFF_AMOUNT" "wf_payoff_amount"
+WF_PAYOFF_QUOTE_DATE="$(json_get '.data.quote_date')"
+require_value "$WF_PAYOFF_QUOTE_DATE" "wf_payoff_quote_date"
+
+WF_PAYOFF_VALID_UNTIL="$(json_get '.data.valid_until')"
+require_value "$WF_PAYOFF_VALID_UNTIL" "wf_payoff_valid_until"
+WF_PAYOFF_EXPIRED_DATE="$($JQ_BIN -rn --arg d "$WF_PAYOFF_VALID_UNTIL" '$d | fromdateiso8601 + 86400 | todate')"
+
+# paying after valid_until is rejected; the customer has to request a new quote
+WF_EXPIRED_PAYOFF_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --argjson quote_id "$WF_PAYOFF_QUOTE_ID" --argjson jumlah_bayar "$WF_PAYOFF_AMOUNT" --arg nomor_bukti "PAY-PAYOFF-EXP-${RUN_KEY}" --arg tanggal_bayar "$WF_PAYOFF_EXPIRED_DATE" '{contract_id:$contract_id,quote_id:$quote_id,nomor_bukti:$nomor_bukti,jumlah_bayar:$jumlah_bayar,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+WF_EXPIRED_PAYOFF_STATUS="$(http_status POST "/leasing/workflow/payoff" "$AUTH_TOKEN" "$WF_EXPIRED_PAYOFF_PAYLOAD")"
+[[ "$WF_EXPIRED_PAYOFF_STATUS" == "400" ]] || fail "Payoff after valid_until should be 400, got ${WF_EXPIRED_PAYOFF_STATUS}"
+
+# a payoff without a stored quote is rejected
+WF_UNQUOTED_PAYOFF_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --argjson jumlah_bayar "$WF_PAYOFF_AMOUNT" --arg nomor_bukti "PAY-PAYOFF-NQ-${RUN_KEY}" --arg tanggal_bayar "$WF_PAYOFF_QUOTE_DATE" '{contract_id:$contract_id,nomor_bukti:$nomor_bukti,jumlah_bayar:$jumlah_bayar,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA"}')"
+WF_UNQUOTED_PAYOFF_STATUS="$(http_status POST "/leasing/workflow/payoff" "$AUTH_TOKEN" "$WF_UNQUOTED_PAYOFF_PAYLOAD")"
+[[ "$WF_UNQUOTED_PAYOFF_STATUS" == "400" ]] || fail "Payoff without quote_id should be 400, got ${WF_UNQUOTED_PAYOFF_STATUS}"
+
+WF_PAYOFF_PAYLOAD="$($JQ_BIN -nc --argjson contract_id "$WF_CONTRACT_ID" --argjson quote_id "$WF_PAYOFF_QUOTE_ID" --argjson jumlah_bayar "$WF_PAYOFF_AMOUNT" --arg nomor_bukti "PAY-PAYOFF-${RUN_KEY}" --arg tanggal_bayar "$WF_PAYOFF_QUOTE_DATE" '{contract_id:$contract_id,quote_id:$quote_id,nomor_bukti:$nomor_bukti,jumlah_bayar:$jumlah_bayar,tanggal_bayar:$tanggal_bayar,metode_pembayaran:"transfer",provider:"BCA",note:"pelunasan dipercepat"}')"
+workflow_post "/leasing/workflow/payoff" "200" "$WF_PAYOFF_PAYLOAD"
+[[ "$(json_get '.data.quote.quote_id')" == "$WF_PAYOFF_QUOTE_ID" ]] || fail "Payoff should settle the stored quote ${WF_PAYOFF_QUOTE_ID}"
+WF_PAYOFF_STATUS="$(json_get '.data.contract_status')"
+[[ "$WF_PAYOFF_STATUS" == "paid_off" ]] || fail "Contract should be paid_off after payoff, got ${WF_PAYOFF_STATUS}"
 
 # -----------------------------
 # DELETE / CLEANUP (reverse dependencies)
@@ -501,11 +915,13 @@ delete_resource "/leasing/leasing_contract" "$WF_CONTRACT_ID"
 # product
 
 delete_resource "/leasing/leasing_product" "$PRODUCT_ID"
+delete_resource "/leasing/insurance_rates" "$RATE_ID"
 
 # dealer
 delete_resource "/dealer/motor_assets" "$MOAS_ID"
 delete_resource "/dealer/motors" "$MOTOR_ID"
 delete_resource "/dealer/motors" "$WF_MOTOR_ID"
+delete_resource "/dealer/customer_blacklist" "$BLACKLIST_ID"
 delete_resource "/dealer/customer" "$CUSTOMER_ID"
 delete_resource "/dealer/customer" "$WF_CUSTOMER_ID"
 delete_resource "/dealer/motor_types" "$MOTY_ID"
@@ -551,7 +967,9 @@ CRUD_RESOURCES=(
   "/dealer/motors"
   "/dealer/motor_assets"
   "/dealer/customer"
+  "/dealer/customer_blacklist"
   "/leasing/leasing_product"
+  "/leasing/insurance_rates"
   "/leasing/leasing_contract"
   "/leasing/leasing_tasks"
   "/leasing/leasing_tasks_attributes"
@@ -569,6 +987,10 @@ WORKFLOW_ENDPOINTS=(
   "/leasing/workflow/initial-payment"
   "/leasing/workflow/dealer-fulfillment"
   "/leasing/workflow/delivery"
+  "/leasing/workflow/payoff-quote"
+  "/leasing/simulate"
+  "/leasing/workflow/payoff"
+  "/payment/payments/posting"
 )
 
 for resource in "${CRUD_RESOURCES[@]}"; do
@@ -591,4 +1013,31 @@ for endpoint in "${WORKFLOW_ENDPOINTS[@]}"; do
   fi
 done
 
+AUTH_ENDPOINTS=(
+  "POST /account/auth/login"
+  "POST /account/auth/refresh"
+  "POST /account/auth/logout"
+  "GET /account/auth/me"
+)
+
+for requirement in "${AUTH_ENDPOINTS[@]}"; do
+  if ! grep -Fxq "$requirement" "$TRACE_FILE"; then
+    fail "Coverage missing: ${requirement}"
+  fi
+done
+
+FILE_ENDPOINTS=(
+  "POST /leasing/leasing_contract_documents/upload"
+  "GET /leasing/leasing_contract_documents/:id/download"
+  "GET /leasing/leasing_contract_documents/:id/signed-url"
+  "POST /dealer/motor_assets/upload"
+  "GET /dealer/motor_assets/:id/download"
+)
+
+for requirement in "${FILE_ENDPOINTS[@]}"; do
+  if ! grep -Fxq "$requirement" "$TRACE_FILE"; then
+    fail "Coverage missing: ${requirement}"
+  fi
+done
+
 log "All endpoint tests passed and coverage is complete."